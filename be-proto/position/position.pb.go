@@ -0,0 +1,1652 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.15.8
+// source: position/position.proto
+
+package position
+
+import (
+	general "github.com/paper-trade-chatbot/be-proto/general"
+	product "github.com/paper-trade-chatbot/be-proto/product"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TradeType int32
+
+const (
+	TradeType_TradeType_None TradeType = 0
+	TradeType_TradeType_Buy  TradeType = 1
+	TradeType_TradeType_Sell TradeType = 2
+)
+
+// Enum value maps for TradeType.
+var (
+	TradeType_name = map[int32]string{
+		0: "TradeType_None",
+		1: "TradeType_Buy",
+		2: "TradeType_Sell",
+	}
+	TradeType_value = map[string]int32{
+		"TradeType_None": 0,
+		"TradeType_Buy":  1,
+		"TradeType_Sell": 2,
+	}
+)
+
+func (x TradeType) Enum() *TradeType {
+	p := new(TradeType)
+	*p = x
+	return p
+}
+
+func (x TradeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_position_position_proto_enumTypes[0].Descriptor()
+}
+
+func (TradeType) Type() protoreflect.EnumType {
+	return &file_position_position_proto_enumTypes[0]
+}
+
+func (x TradeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradeType.Descriptor instead.
+func (TradeType) EnumDescriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{0}
+}
+
+type PositionStatus int32
+
+const (
+	PositionStatus_PositionStatus_None  PositionStatus = 0
+	PositionStatus_PositionStatus_Open  PositionStatus = 1
+	PositionStatus_PositionStatus_Close PositionStatus = 2
+)
+
+// Enum value maps for PositionStatus.
+var (
+	PositionStatus_name = map[int32]string{
+		0: "PositionStatus_None",
+		1: "PositionStatus_Open",
+		2: "PositionStatus_Close",
+	}
+	PositionStatus_value = map[string]int32{
+		"PositionStatus_None":  0,
+		"PositionStatus_Open":  1,
+		"PositionStatus_Close": 2,
+	}
+)
+
+func (x PositionStatus) Enum() *PositionStatus {
+	p := new(PositionStatus)
+	*p = x
+	return p
+}
+
+func (x PositionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PositionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_position_position_proto_enumTypes[1].Descriptor()
+}
+
+func (PositionStatus) Type() protoreflect.EnumType {
+	return &file_position_position_proto_enumTypes[1]
+}
+
+func (x PositionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PositionStatus.Descriptor instead.
+func (PositionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{1}
+}
+
+type ProcessState int32
+
+const (
+	ProcessState_ProcessState_None           ProcessState = 0
+	ProcessState_ProcessState_Open           ProcessState = 1
+	ProcessState_ProcessState_PendingToClose ProcessState = 2
+	ProcessState_ProcessState_Close          ProcessState = 3
+)
+
+// Enum value maps for ProcessState.
+var (
+	ProcessState_name = map[int32]string{
+		0: "ProcessState_None",
+		1: "ProcessState_Open",
+		2: "ProcessState_PendingToClose",
+		3: "ProcessState_Close",
+	}
+	ProcessState_value = map[string]int32{
+		"ProcessState_None":           0,
+		"ProcessState_Open":           1,
+		"ProcessState_PendingToClose": 2,
+		"ProcessState_Close":          3,
+	}
+)
+
+func (x ProcessState) Enum() *ProcessState {
+	p := new(ProcessState)
+	*p = x
+	return p
+}
+
+func (x ProcessState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProcessState) Descriptor() protoreflect.EnumDescriptor {
+	return file_position_position_proto_enumTypes[2].Descriptor()
+}
+
+func (ProcessState) Type() protoreflect.EnumType {
+	return &file_position_position_proto_enumTypes[2]
+}
+
+func (x ProcessState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProcessState.Descriptor instead.
+func (ProcessState) EnumDescriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{2}
+}
+
+type GetPositionsReq_OrderBy int32
+
+const (
+	GetPositionsReq_OrderBy_None        GetPositionsReq_OrderBy = 0
+	GetPositionsReq_OrderBy_ProductCode GetPositionsReq_OrderBy = 1
+	GetPositionsReq_OrderBy_CreatedAt   GetPositionsReq_OrderBy = 2
+)
+
+// Enum value maps for GetPositionsReq_OrderBy.
+var (
+	GetPositionsReq_OrderBy_name = map[int32]string{
+		0: "OrderBy_None",
+		1: "OrderBy_ProductCode",
+		2: "OrderBy_CreatedAt",
+	}
+	GetPositionsReq_OrderBy_value = map[string]int32{
+		"OrderBy_None":        0,
+		"OrderBy_ProductCode": 1,
+		"OrderBy_CreatedAt":   2,
+	}
+)
+
+func (x GetPositionsReq_OrderBy) Enum() *GetPositionsReq_OrderBy {
+	p := new(GetPositionsReq_OrderBy)
+	*p = x
+	return p
+}
+
+func (x GetPositionsReq_OrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPositionsReq_OrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_position_position_proto_enumTypes[3].Descriptor()
+}
+
+func (GetPositionsReq_OrderBy) Type() protoreflect.EnumType {
+	return &file_position_position_proto_enumTypes[3]
+}
+
+func (x GetPositionsReq_OrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPositionsReq_OrderBy.Descriptor instead.
+func (GetPositionsReq_OrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type GetPositionsReq_OrderDirection int32
+
+const (
+	GetPositionsReq_OrderDirection_None GetPositionsReq_OrderDirection = 0
+	GetPositionsReq_OrderDirection_ASC  GetPositionsReq_OrderDirection = 1
+	GetPositionsReq_OrderDirection_DESC GetPositionsReq_OrderDirection = -1
+)
+
+// Enum value maps for GetPositionsReq_OrderDirection.
+var (
+	GetPositionsReq_OrderDirection_name = map[int32]string{
+		0:  "OrderDirection_None",
+		1:  "OrderDirection_ASC",
+		-1: "OrderDirection_DESC",
+	}
+	GetPositionsReq_OrderDirection_value = map[string]int32{
+		"OrderDirection_None": 0,
+		"OrderDirection_ASC":  1,
+		"OrderDirection_DESC": -1,
+	}
+)
+
+func (x GetPositionsReq_OrderDirection) Enum() *GetPositionsReq_OrderDirection {
+	p := new(GetPositionsReq_OrderDirection)
+	*p = x
+	return p
+}
+
+func (x GetPositionsReq_OrderDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetPositionsReq_OrderDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_position_position_proto_enumTypes[4].Descriptor()
+}
+
+func (GetPositionsReq_OrderDirection) Type() protoreflect.EnumType {
+	return &file_position_position_proto_enumTypes[4]
+}
+
+func (x GetPositionsReq_OrderDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetPositionsReq_OrderDirection.Descriptor instead.
+func (GetPositionsReq_OrderDirection) EnumDescriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{8, 1}
+}
+
+type OpenPositionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemberID     uint64              `protobuf:"varint,1,opt,name=memberID,proto3" json:"memberID,omitempty"`
+	Type         product.ProductType `protobuf:"varint,2,opt,name=type,proto3,enum=product.ProductType" json:"type,omitempty"`
+	ExchangeCode string              `protobuf:"bytes,3,opt,name=exchangeCode,proto3" json:"exchangeCode,omitempty"`
+	ProductCode  string              `protobuf:"bytes,4,opt,name=productCode,proto3" json:"productCode,omitempty"`
+	TradeType    TradeType           `protobuf:"varint,5,opt,name=tradeType,proto3,enum=position.TradeType" json:"tradeType,omitempty"`
+	Amount       string              `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	UnitPrice    string              `protobuf:"bytes,7,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+}
+
+func (x *OpenPositionReq) Reset() {
+	*x = OpenPositionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPositionReq) ProtoMessage() {}
+
+func (x *OpenPositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPositionReq.ProtoReflect.Descriptor instead.
+func (*OpenPositionReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenPositionReq) GetMemberID() uint64 {
+	if x != nil {
+		return x.MemberID
+	}
+	return 0
+}
+
+func (x *OpenPositionReq) GetType() product.ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return product.ProductType(0)
+}
+
+func (x *OpenPositionReq) GetExchangeCode() string {
+	if x != nil {
+		return x.ExchangeCode
+	}
+	return ""
+}
+
+func (x *OpenPositionReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *OpenPositionReq) GetTradeType() TradeType {
+	if x != nil {
+		return x.TradeType
+	}
+	return TradeType_TradeType_None
+}
+
+func (x *OpenPositionReq) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *OpenPositionReq) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+type OpenPositionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *OpenPositionRes) Reset() {
+	*x = OpenPositionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPositionRes) ProtoMessage() {}
+
+func (x *OpenPositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPositionRes.ProtoReflect.Descriptor instead.
+func (*OpenPositionRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenPositionRes) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PendingToClosePositionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CloseAmount string `protobuf:"bytes,2,opt,name=closeAmount,proto3" json:"closeAmount,omitempty"` //為正值，代表該倉要減去多少數量
+}
+
+func (x *PendingToClosePositionReq) Reset() {
+	*x = PendingToClosePositionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingToClosePositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingToClosePositionReq) ProtoMessage() {}
+
+func (x *PendingToClosePositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingToClosePositionReq.ProtoReflect.Descriptor instead.
+func (*PendingToClosePositionReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PendingToClosePositionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PendingToClosePositionReq) GetCloseAmount() string {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return ""
+}
+
+type PendingToClosePositionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreemptSuccess bool `protobuf:"varint,1,opt,name=preemptSuccess,proto3" json:"preemptSuccess,omitempty"` //是否搶到pending成功
+}
+
+func (x *PendingToClosePositionRes) Reset() {
+	*x = PendingToClosePositionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingToClosePositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingToClosePositionRes) ProtoMessage() {}
+
+func (x *PendingToClosePositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingToClosePositionRes.ProtoReflect.Descriptor instead.
+func (*PendingToClosePositionRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PendingToClosePositionRes) GetPreemptSuccess() bool {
+	if x != nil {
+		return x.PreemptSuccess
+	}
+	return false
+}
+
+type StopPendingPositionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StopPendingPositionReq) Reset() {
+	*x = StopPendingPositionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPendingPositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPendingPositionReq) ProtoMessage() {}
+
+func (x *StopPendingPositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPendingPositionReq.ProtoReflect.Descriptor instead.
+func (*StopPendingPositionReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopPendingPositionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type StopPendingPositionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopPendingPositionRes) Reset() {
+	*x = StopPendingPositionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopPendingPositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopPendingPositionRes) ProtoMessage() {}
+
+func (x *StopPendingPositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopPendingPositionRes.ProtoReflect.Descriptor instead.
+func (*StopPendingPositionRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{5}
+}
+
+type ClosePositionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CloseAmount string `protobuf:"bytes,2,opt,name=closeAmount,proto3" json:"closeAmount,omitempty"` //為正值，代表該倉要減去多少數量
+}
+
+func (x *ClosePositionReq) Reset() {
+	*x = ClosePositionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePositionReq) ProtoMessage() {}
+
+func (x *ClosePositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePositionReq.ProtoReflect.Descriptor instead.
+func (*ClosePositionReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ClosePositionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClosePositionReq) GetCloseAmount() string {
+	if x != nil {
+		return x.CloseAmount
+	}
+	return ""
+}
+
+type ClosePositionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Closed     bool   `protobuf:"varint,1,opt,name=closed,proto3" json:"closed,omitempty"`
+	AmountLeft string `protobuf:"bytes,2,opt,name=amountLeft,proto3" json:"amountLeft,omitempty"`
+}
+
+func (x *ClosePositionRes) Reset() {
+	*x = ClosePositionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePositionRes) ProtoMessage() {}
+
+func (x *ClosePositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePositionRes.ProtoReflect.Descriptor instead.
+func (*ClosePositionRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClosePositionRes) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *ClosePositionRes) GetAmountLeft() string {
+	if x != nil {
+		return x.AmountLeft
+	}
+	return ""
+}
+
+type GetPositionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           []uint64                 `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
+	MemberID     []uint64                 `protobuf:"varint,2,rep,packed,name=memberID,proto3" json:"memberID,omitempty"`
+	Type         *product.ProductType     `protobuf:"varint,3,opt,name=type,proto3,enum=product.ProductType,oneof" json:"type,omitempty"`
+	ExchangeCode *string                  `protobuf:"bytes,4,opt,name=exchangeCode,proto3,oneof" json:"exchangeCode,omitempty"`
+	ProductCode  *string                  `protobuf:"bytes,5,opt,name=productCode,proto3,oneof" json:"productCode,omitempty"`
+	TradeType    *TradeType               `protobuf:"varint,6,opt,name=tradeType,proto3,enum=position.TradeType,oneof" json:"tradeType,omitempty"`
+	Status       *PositionStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=position.PositionStatus,oneof" json:"status,omitempty"`
+	Order        []*GetPositionsReq_Order `protobuf:"bytes,8,rep,name=order,proto3" json:"order,omitempty"`
+	Pagination   *general.Pagination      `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetPositionsReq) Reset() {
+	*x = GetPositionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPositionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionsReq) ProtoMessage() {}
+
+func (x *GetPositionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionsReq.ProtoReflect.Descriptor instead.
+func (*GetPositionsReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPositionsReq) GetId() []uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetPositionsReq) GetMemberID() []uint64 {
+	if x != nil {
+		return x.MemberID
+	}
+	return nil
+}
+
+func (x *GetPositionsReq) GetType() product.ProductType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return product.ProductType(0)
+}
+
+func (x *GetPositionsReq) GetExchangeCode() string {
+	if x != nil && x.ExchangeCode != nil {
+		return *x.ExchangeCode
+	}
+	return ""
+}
+
+func (x *GetPositionsReq) GetProductCode() string {
+	if x != nil && x.ProductCode != nil {
+		return *x.ProductCode
+	}
+	return ""
+}
+
+func (x *GetPositionsReq) GetTradeType() TradeType {
+	if x != nil && x.TradeType != nil {
+		return *x.TradeType
+	}
+	return TradeType_TradeType_None
+}
+
+func (x *GetPositionsReq) GetStatus() PositionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PositionStatus_PositionStatus_None
+}
+
+func (x *GetPositionsReq) GetOrder() []*GetPositionsReq_Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *GetPositionsReq) GetPagination() *general.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MemberID     uint64              `protobuf:"varint,2,opt,name=memberID,proto3" json:"memberID,omitempty"`
+	Type         product.ProductType `protobuf:"varint,3,opt,name=type,proto3,enum=product.ProductType" json:"type,omitempty"`
+	ExchangeCode string              `protobuf:"bytes,4,opt,name=exchangeCode,proto3" json:"exchangeCode,omitempty"`
+	ProductCode  string              `protobuf:"bytes,5,opt,name=productCode,proto3" json:"productCode,omitempty"`
+	TradeType    TradeType           `protobuf:"varint,6,opt,name=tradeType,proto3,enum=position.TradeType" json:"tradeType,omitempty"`
+	Status       PositionStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=position.PositionStatus" json:"status,omitempty"`
+	ProcessState ProcessState        `protobuf:"varint,8,opt,name=processState,proto3,enum=position.ProcessState" json:"processState,omitempty"`
+	Amount       string              `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	UnitPrice    string              `protobuf:"bytes,10,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	CreatedAt    int64               `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt    int64               `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Position) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Position) GetMemberID() uint64 {
+	if x != nil {
+		return x.MemberID
+	}
+	return 0
+}
+
+func (x *Position) GetType() product.ProductType {
+	if x != nil {
+		return x.Type
+	}
+	return product.ProductType(0)
+}
+
+func (x *Position) GetExchangeCode() string {
+	if x != nil {
+		return x.ExchangeCode
+	}
+	return ""
+}
+
+func (x *Position) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *Position) GetTradeType() TradeType {
+	if x != nil {
+		return x.TradeType
+	}
+	return TradeType_TradeType_None
+}
+
+func (x *Position) GetStatus() PositionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PositionStatus_PositionStatus_None
+}
+
+func (x *Position) GetProcessState() ProcessState {
+	if x != nil {
+		return x.ProcessState
+	}
+	return ProcessState_ProcessState_None
+}
+
+func (x *Position) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Position) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+func (x *Position) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Position) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetPositionsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions      []*Position             `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	PaginationInfo *general.PaginationInfo `protobuf:"bytes,2,opt,name=paginationInfo,proto3" json:"paginationInfo,omitempty"`
+}
+
+func (x *GetPositionsRes) Reset() {
+	*x = GetPositionsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPositionsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionsRes) ProtoMessage() {}
+
+func (x *GetPositionsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionsRes.ProtoReflect.Descriptor instead.
+func (*GetPositionsRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPositionsRes) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *GetPositionsRes) GetPaginationInfo() *general.PaginationInfo {
+	if x != nil {
+		return x.PaginationInfo
+	}
+	return nil
+}
+
+type ModifyPositionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount    *string         `protobuf:"bytes,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	UnitPrice *string         `protobuf:"bytes,3,opt,name=unitPrice,proto3,oneof" json:"unitPrice,omitempty"`
+	Status    *PositionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=position.PositionStatus,oneof" json:"status,omitempty"`
+}
+
+func (x *ModifyPositionReq) Reset() {
+	*x = ModifyPositionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyPositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyPositionReq) ProtoMessage() {}
+
+func (x *ModifyPositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyPositionReq.ProtoReflect.Descriptor instead.
+func (*ModifyPositionReq) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModifyPositionReq) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModifyPositionReq) GetAmount() string {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return ""
+}
+
+func (x *ModifyPositionReq) GetUnitPrice() string {
+	if x != nil && x.UnitPrice != nil {
+		return *x.UnitPrice
+	}
+	return ""
+}
+
+func (x *ModifyPositionReq) GetStatus() PositionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PositionStatus_PositionStatus_None
+}
+
+type ModifyPositionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ModifyPositionRes) Reset() {
+	*x = ModifyPositionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyPositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyPositionRes) ProtoMessage() {}
+
+func (x *ModifyPositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyPositionRes.ProtoReflect.Descriptor instead.
+func (*ModifyPositionRes) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPositionsReq_Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderBy        GetPositionsReq_OrderBy        `protobuf:"varint,1,opt,name=orderBy,proto3,enum=position.GetPositionsReq_OrderBy" json:"orderBy,omitempty"`
+	OrderDirection GetPositionsReq_OrderDirection `protobuf:"varint,2,opt,name=orderDirection,proto3,enum=position.GetPositionsReq_OrderDirection" json:"orderDirection,omitempty"`
+}
+
+func (x *GetPositionsReq_Order) Reset() {
+	*x = GetPositionsReq_Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_position_position_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPositionsReq_Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPositionsReq_Order) ProtoMessage() {}
+
+func (x *GetPositionsReq_Order) ProtoReflect() protoreflect.Message {
+	mi := &file_position_position_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPositionsReq_Order.ProtoReflect.Descriptor instead.
+func (*GetPositionsReq_Order) Descriptor() ([]byte, []int) {
+	return file_position_position_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *GetPositionsReq_Order) GetOrderBy() GetPositionsReq_OrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return GetPositionsReq_OrderBy_None
+}
+
+func (x *GetPositionsReq_Order) GetOrderDirection() GetPositionsReq_OrderDirection {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return GetPositionsReq_OrderDirection_None
+}
+
+var File_position_position_proto protoreflect.FileDescriptor
+
+var file_position_position_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x86, 0x02, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a,
+	0x19, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x19,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x65, 0x6d, 0x70, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x28, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x10, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x4c, 0x65, 0x66, 0x74, 0x22, 0xa5, 0x06, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x03,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x04, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x96, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x50, 0x0a, 0x0e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x5f, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x5f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x10, 0x02, 0x22, 0x63, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x4e, 0x6f, 0x6e, 0x65,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x13, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x44, 0x45, 0x53,
+	0x43, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0xb9, 0x03, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x2a, 0x46, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x42, 0x75, 0x79, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x65, 0x6c, 0x6c, 0x10, 0x02,
+	0x2a, 0x5c, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4f, 0x70,
+	0x65, 0x6e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x02, 0x2a, 0x75,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x4f, 0x70, 0x65, 0x6e, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x10, 0x03, 0x32, 0xf1, 0x03, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x4f, 0x70, 0x65,
+	0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12,
+	0x62, 0x0a, 0x16, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23,
+	0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x47,
+	0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x12, 0x4a, 0x0a,
+	0x0e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x61, 0x70, 0x65, 0x72, 0x2d, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x2d, 0x63, 0x68, 0x61, 0x74, 0x62, 0x6f, 0x74, 0x2f, 0x62, 0x65, 0x2d, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_position_position_proto_rawDescOnce sync.Once
+	file_position_position_proto_rawDescData = file_position_position_proto_rawDesc
+)
+
+func file_position_position_proto_rawDescGZIP() []byte {
+	file_position_position_proto_rawDescOnce.Do(func() {
+		file_position_position_proto_rawDescData = protoimpl.X.CompressGZIP(file_position_position_proto_rawDescData)
+	})
+	return file_position_position_proto_rawDescData
+}
+
+var file_position_position_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_position_position_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_position_position_proto_goTypes = []interface{}{
+	(TradeType)(0),                      // 0: position.TradeType
+	(PositionStatus)(0),                 // 1: position.PositionStatus
+	(ProcessState)(0),                   // 2: position.ProcessState
+	(GetPositionsReq_OrderBy)(0),        // 3: position.GetPositionsReq.OrderBy
+	(GetPositionsReq_OrderDirection)(0), // 4: position.GetPositionsReq.OrderDirection
+	(*OpenPositionReq)(nil),             // 5: position.OpenPositionReq
+	(*OpenPositionRes)(nil),             // 6: position.OpenPositionRes
+	(*PendingToClosePositionReq)(nil),   // 7: position.PendingToClosePositionReq
+	(*PendingToClosePositionRes)(nil),   // 8: position.PendingToClosePositionRes
+	(*StopPendingPositionReq)(nil),      // 9: position.StopPendingPositionReq
+	(*StopPendingPositionRes)(nil),      // 10: position.StopPendingPositionRes
+	(*ClosePositionReq)(nil),            // 11: position.ClosePositionReq
+	(*ClosePositionRes)(nil),            // 12: position.ClosePositionRes
+	(*GetPositionsReq)(nil),             // 13: position.GetPositionsReq
+	(*Position)(nil),                    // 14: position.Position
+	(*GetPositionsRes)(nil),             // 15: position.GetPositionsRes
+	(*ModifyPositionReq)(nil),           // 16: position.ModifyPositionReq
+	(*ModifyPositionRes)(nil),           // 17: position.ModifyPositionRes
+	(*GetPositionsReq_Order)(nil),       // 18: position.GetPositionsReq.Order
+	(product.ProductType)(0),            // 19: product.ProductType
+	(*general.Pagination)(nil),          // 20: general.Pagination
+	(*general.PaginationInfo)(nil),      // 21: general.PaginationInfo
+}
+var file_position_position_proto_depIdxs = []int32{
+	19, // 0: position.OpenPositionReq.type:type_name -> product.ProductType
+	0,  // 1: position.OpenPositionReq.tradeType:type_name -> position.TradeType
+	19, // 2: position.GetPositionsReq.type:type_name -> product.ProductType
+	0,  // 3: position.GetPositionsReq.tradeType:type_name -> position.TradeType
+	1,  // 4: position.GetPositionsReq.status:type_name -> position.PositionStatus
+	18, // 5: position.GetPositionsReq.order:type_name -> position.GetPositionsReq.Order
+	20, // 6: position.GetPositionsReq.pagination:type_name -> general.Pagination
+	19, // 7: position.Position.type:type_name -> product.ProductType
+	0,  // 8: position.Position.tradeType:type_name -> position.TradeType
+	1,  // 9: position.Position.status:type_name -> position.PositionStatus
+	2,  // 10: position.Position.processState:type_name -> position.ProcessState
+	14, // 11: position.GetPositionsRes.positions:type_name -> position.Position
+	21, // 12: position.GetPositionsRes.paginationInfo:type_name -> general.PaginationInfo
+	1,  // 13: position.ModifyPositionReq.status:type_name -> position.PositionStatus
+	3,  // 14: position.GetPositionsReq.Order.orderBy:type_name -> position.GetPositionsReq.OrderBy
+	4,  // 15: position.GetPositionsReq.Order.orderDirection:type_name -> position.GetPositionsReq.OrderDirection
+	5,  // 16: position.PositionService.OpenPosition:input_type -> position.OpenPositionReq
+	7,  // 17: position.PositionService.PendingToClosePosition:input_type -> position.PendingToClosePositionReq
+	9,  // 18: position.PositionService.StopPendingPosition:input_type -> position.StopPendingPositionReq
+	11, // 19: position.PositionService.ClosePosition:input_type -> position.ClosePositionReq
+	13, // 20: position.PositionService.GetPositions:input_type -> position.GetPositionsReq
+	16, // 21: position.PositionService.ModifyPosition:input_type -> position.ModifyPositionReq
+	6,  // 22: position.PositionService.OpenPosition:output_type -> position.OpenPositionRes
+	8,  // 23: position.PositionService.PendingToClosePosition:output_type -> position.PendingToClosePositionRes
+	10, // 24: position.PositionService.StopPendingPosition:output_type -> position.StopPendingPositionRes
+	12, // 25: position.PositionService.ClosePosition:output_type -> position.ClosePositionRes
+	15, // 26: position.PositionService.GetPositions:output_type -> position.GetPositionsRes
+	17, // 27: position.PositionService.ModifyPosition:output_type -> position.ModifyPositionRes
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_position_position_proto_init() }
+func file_position_position_proto_init() {
+	if File_position_position_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_position_position_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPositionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingToClosePositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingToClosePositionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPendingPositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopPendingPositionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePositionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPositionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPositionsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyPositionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyPositionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_position_position_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPositionsReq_Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_position_position_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_position_position_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_position_position_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_position_position_proto_goTypes,
+		DependencyIndexes: file_position_position_proto_depIdxs,
+		EnumInfos:         file_position_position_proto_enumTypes,
+		MessageInfos:      file_position_position_proto_msgTypes,
+	}.Build()
+	File_position_position_proto = out.File
+	file_position_position_proto_rawDesc = nil
+	file_position_position_proto_goTypes = nil
+	file_position_position_proto_depIdxs = nil
+}
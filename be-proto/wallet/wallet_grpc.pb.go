@@ -0,0 +1,1067 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: wallet/wallet.proto
+
+package wallet
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	CreateWallet(ctx context.Context, in *CreateWalletReq, opts ...grpc.CallOption) (*CreateWalletRes, error)
+	GetWallets(ctx context.Context, in *GetWalletsReq, opts ...grpc.CallOption) (*GetWalletsRes, error)
+	DeleteWallet(ctx context.Context, in *DeleteWalletReq, opts ...grpc.CallOption) (*DeleteWalletRes, error)
+	SetWalletStatus(ctx context.Context, in *SetWalletStatusReq, opts ...grpc.CallOption) (*SetWalletStatusRes, error)
+	CloseMemberWallets(ctx context.Context, in *CloseMemberWalletsReq, opts ...grpc.CallOption) (*CloseMemberWalletsRes, error)
+	Transaction(ctx context.Context, in *TransactionReq, opts ...grpc.CallOption) (*TransactionRes, error)
+	RollbackTransaction(ctx context.Context, in *RollbackTransactionReq, opts ...grpc.CallOption) (*RollbackTransactionRes, error)
+	Transfer(ctx context.Context, in *TransferReq, opts ...grpc.CallOption) (*TransferRes, error)
+	Exchange(ctx context.Context, in *ExchangeReq, opts ...grpc.CallOption) (*ExchangeRes, error)
+	GetMemberEquity(ctx context.Context, in *GetMemberEquityReq, opts ...grpc.CallOption) (*GetMemberEquityRes, error)
+	PrepareTransaction(ctx context.Context, in *PrepareTransactionReq, opts ...grpc.CallOption) (*TransactionRes, error)
+	ConfirmTransaction(ctx context.Context, in *ConfirmTransactionReq, opts ...grpc.CallOption) (*TransactionRes, error)
+	CancelTransaction(ctx context.Context, in *CancelTransactionReq, opts ...grpc.CallOption) (*CancelTransactionRes, error)
+	GetTransactionRecord(ctx context.Context, in *GetTransactionRecordReq, opts ...grpc.CallOption) (*GetTransactionRecordRes, error)
+	GetTransactionRecords(ctx context.Context, in *GetTransactionRecordsReq, opts ...grpc.CallOption) (*GetTransactionRecordsRes, error)
+	GetBalanceAt(ctx context.Context, in *GetBalanceAtReq, opts ...grpc.CallOption) (*GetBalanceAtRes, error)
+	GetBalanceSnapshots(ctx context.Context, in *GetBalanceSnapshotsReq, opts ...grpc.CallOption) (*GetBalanceSnapshotsRes, error)
+	SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsReq, opts ...grpc.CallOption) (WalletService_SubscribeWalletEventsClient, error)
+	Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileRes, error)
+	GetReconciliationDiscrepancies(ctx context.Context, in *GetReconciliationDiscrepanciesReq, opts ...grpc.CallOption) (*GetReconciliationDiscrepanciesRes, error)
+	CreateBonusCampaign(ctx context.Context, in *CreateBonusCampaignReq, opts ...grpc.CallOption) (*CreateBonusCampaignRes, error)
+	GetBonusCampaigns(ctx context.Context, in *GetBonusCampaignsReq, opts ...grpc.CallOption) (*GetBonusCampaignsRes, error)
+	GrantCampaignBonus(ctx context.Context, in *GrantCampaignBonusReq, opts ...grpc.CallOption) (*TransactionRes, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookRes, error)
+	GetWebhooks(ctx context.Context, in *GetWebhooksReq, opts ...grpc.CallOption) (*GetWebhooksRes, error)
+	SetWebhookEnabled(ctx context.Context, in *SetWebhookEnabledReq, opts ...grpc.CallOption) (*SetWebhookEnabledRes, error)
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesReq, opts ...grpc.CallOption) (*GetWebhookDeliveriesRes, error)
+}
+
+type walletServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletServiceClient(cc grpc.ClientConnInterface) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) CreateWallet(ctx context.Context, in *CreateWalletReq, opts ...grpc.CallOption) (*CreateWalletRes, error) {
+	out := new(CreateWalletRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/CreateWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetWallets(ctx context.Context, in *GetWalletsReq, opts ...grpc.CallOption) (*GetWalletsRes, error) {
+	out := new(GetWalletsRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetWallets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeleteWallet(ctx context.Context, in *DeleteWalletReq, opts ...grpc.CallOption) (*DeleteWalletRes, error) {
+	out := new(DeleteWalletRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/DeleteWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetWalletStatus(ctx context.Context, in *SetWalletStatusReq, opts ...grpc.CallOption) (*SetWalletStatusRes, error) {
+	out := new(SetWalletStatusRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/SetWalletStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CloseMemberWallets(ctx context.Context, in *CloseMemberWalletsReq, opts ...grpc.CallOption) (*CloseMemberWalletsRes, error) {
+	out := new(CloseMemberWalletsRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/CloseMemberWallets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Transaction(ctx context.Context, in *TransactionReq, opts ...grpc.CallOption) (*TransactionRes, error) {
+	out := new(TransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/Transaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RollbackTransaction(ctx context.Context, in *RollbackTransactionReq, opts ...grpc.CallOption) (*RollbackTransactionRes, error) {
+	out := new(RollbackTransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/RollbackTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Transfer(ctx context.Context, in *TransferReq, opts ...grpc.CallOption) (*TransferRes, error) {
+	out := new(TransferRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Exchange(ctx context.Context, in *ExchangeReq, opts ...grpc.CallOption) (*ExchangeRes, error) {
+	out := new(ExchangeRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/Exchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetMemberEquity(ctx context.Context, in *GetMemberEquityReq, opts ...grpc.CallOption) (*GetMemberEquityRes, error) {
+	out := new(GetMemberEquityRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetMemberEquity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PrepareTransaction(ctx context.Context, in *PrepareTransactionReq, opts ...grpc.CallOption) (*TransactionRes, error) {
+	out := new(TransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/PrepareTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ConfirmTransaction(ctx context.Context, in *ConfirmTransactionReq, opts ...grpc.CallOption) (*TransactionRes, error) {
+	out := new(TransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/ConfirmTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CancelTransaction(ctx context.Context, in *CancelTransactionReq, opts ...grpc.CallOption) (*CancelTransactionRes, error) {
+	out := new(CancelTransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/CancelTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactionRecord(ctx context.Context, in *GetTransactionRecordReq, opts ...grpc.CallOption) (*GetTransactionRecordRes, error) {
+	out := new(GetTransactionRecordRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetTransactionRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactionRecords(ctx context.Context, in *GetTransactionRecordsReq, opts ...grpc.CallOption) (*GetTransactionRecordsRes, error) {
+	out := new(GetTransactionRecordsRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetTransactionRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetBalanceAt(ctx context.Context, in *GetBalanceAtReq, opts ...grpc.CallOption) (*GetBalanceAtRes, error) {
+	out := new(GetBalanceAtRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetBalanceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetBalanceSnapshots(ctx context.Context, in *GetBalanceSnapshotsReq, opts ...grpc.CallOption) (*GetBalanceSnapshotsRes, error) {
+	out := new(GetBalanceSnapshotsRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetBalanceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsReq, opts ...grpc.CallOption) (WalletService_SubscribeWalletEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], "/wallet.WalletService/SubscribeWalletEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceSubscribeWalletEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_SubscribeWalletEventsClient interface {
+	Recv() (*WalletEvent, error)
+	grpc.ClientStream
+}
+
+type walletServiceSubscribeWalletEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceSubscribeWalletEventsClient) Recv() (*WalletEvent, error) {
+	m := new(WalletEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) Reconcile(ctx context.Context, in *ReconcileReq, opts ...grpc.CallOption) (*ReconcileRes, error) {
+	out := new(ReconcileRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/Reconcile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetReconciliationDiscrepancies(ctx context.Context, in *GetReconciliationDiscrepanciesReq, opts ...grpc.CallOption) (*GetReconciliationDiscrepanciesRes, error) {
+	out := new(GetReconciliationDiscrepanciesRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetReconciliationDiscrepancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateBonusCampaign(ctx context.Context, in *CreateBonusCampaignReq, opts ...grpc.CallOption) (*CreateBonusCampaignRes, error) {
+	out := new(CreateBonusCampaignRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/CreateBonusCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetBonusCampaigns(ctx context.Context, in *GetBonusCampaignsReq, opts ...grpc.CallOption) (*GetBonusCampaignsRes, error) {
+	out := new(GetBonusCampaignsRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetBonusCampaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GrantCampaignBonus(ctx context.Context, in *GrantCampaignBonusReq, opts ...grpc.CallOption) (*TransactionRes, error) {
+	out := new(TransactionRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GrantCampaignBonus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookReq, opts ...grpc.CallOption) (*CreateWebhookRes, error) {
+	out := new(CreateWebhookRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/CreateWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetWebhooks(ctx context.Context, in *GetWebhooksReq, opts ...grpc.CallOption) (*GetWebhooksRes, error) {
+	out := new(GetWebhooksRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetWebhookEnabled(ctx context.Context, in *SetWebhookEnabledReq, opts ...grpc.CallOption) (*SetWebhookEnabledRes, error) {
+	out := new(SetWebhookEnabledRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/SetWebhookEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesReq, opts ...grpc.CallOption) (*GetWebhookDeliveriesRes, error) {
+	out := new(GetWebhookDeliveriesRes)
+	err := c.cc.Invoke(ctx, "/wallet.WalletService/GetWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+// All implementations should embed UnimplementedWalletServiceServer
+// for forward compatibility
+type WalletServiceServer interface {
+	CreateWallet(context.Context, *CreateWalletReq) (*CreateWalletRes, error)
+	GetWallets(context.Context, *GetWalletsReq) (*GetWalletsRes, error)
+	DeleteWallet(context.Context, *DeleteWalletReq) (*DeleteWalletRes, error)
+	SetWalletStatus(context.Context, *SetWalletStatusReq) (*SetWalletStatusRes, error)
+	CloseMemberWallets(context.Context, *CloseMemberWalletsReq) (*CloseMemberWalletsRes, error)
+	Transaction(context.Context, *TransactionReq) (*TransactionRes, error)
+	RollbackTransaction(context.Context, *RollbackTransactionReq) (*RollbackTransactionRes, error)
+	Transfer(context.Context, *TransferReq) (*TransferRes, error)
+	Exchange(context.Context, *ExchangeReq) (*ExchangeRes, error)
+	GetMemberEquity(context.Context, *GetMemberEquityReq) (*GetMemberEquityRes, error)
+	PrepareTransaction(context.Context, *PrepareTransactionReq) (*TransactionRes, error)
+	ConfirmTransaction(context.Context, *ConfirmTransactionReq) (*TransactionRes, error)
+	CancelTransaction(context.Context, *CancelTransactionReq) (*CancelTransactionRes, error)
+	GetTransactionRecord(context.Context, *GetTransactionRecordReq) (*GetTransactionRecordRes, error)
+	GetTransactionRecords(context.Context, *GetTransactionRecordsReq) (*GetTransactionRecordsRes, error)
+	GetBalanceAt(context.Context, *GetBalanceAtReq) (*GetBalanceAtRes, error)
+	GetBalanceSnapshots(context.Context, *GetBalanceSnapshotsReq) (*GetBalanceSnapshotsRes, error)
+	SubscribeWalletEvents(*SubscribeWalletEventsReq, WalletService_SubscribeWalletEventsServer) error
+	Reconcile(context.Context, *ReconcileReq) (*ReconcileRes, error)
+	GetReconciliationDiscrepancies(context.Context, *GetReconciliationDiscrepanciesReq) (*GetReconciliationDiscrepanciesRes, error)
+	CreateBonusCampaign(context.Context, *CreateBonusCampaignReq) (*CreateBonusCampaignRes, error)
+	GetBonusCampaigns(context.Context, *GetBonusCampaignsReq) (*GetBonusCampaignsRes, error)
+	GrantCampaignBonus(context.Context, *GrantCampaignBonusReq) (*TransactionRes, error)
+	CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookRes, error)
+	GetWebhooks(context.Context, *GetWebhooksReq) (*GetWebhooksRes, error)
+	SetWebhookEnabled(context.Context, *SetWebhookEnabledReq) (*SetWebhookEnabledRes, error)
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesReq) (*GetWebhookDeliveriesRes, error)
+}
+
+// UnimplementedWalletServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletReq) (*CreateWalletRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWallets(context.Context, *GetWalletsReq) (*GetWalletsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallets not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteWallet(context.Context, *DeleteWalletReq) (*DeleteWalletRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) SetWalletStatus(context.Context, *SetWalletStatusReq) (*SetWalletStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWalletStatus not implemented")
+}
+func (UnimplementedWalletServiceServer) CloseMemberWallets(context.Context, *CloseMemberWalletsReq) (*CloseMemberWalletsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseMemberWallets not implemented")
+}
+func (UnimplementedWalletServiceServer) Transaction(context.Context, *TransactionReq) (*TransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedWalletServiceServer) RollbackTransaction(context.Context, *RollbackTransactionReq) (*RollbackTransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) Transfer(context.Context, *TransferReq) (*TransferRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedWalletServiceServer) Exchange(context.Context, *ExchangeReq) (*ExchangeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exchange not implemented")
+}
+func (UnimplementedWalletServiceServer) GetMemberEquity(context.Context, *GetMemberEquityReq) (*GetMemberEquityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberEquity not implemented")
+}
+func (UnimplementedWalletServiceServer) PrepareTransaction(context.Context, *PrepareTransactionReq) (*TransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) ConfirmTransaction(context.Context, *ConfirmTransactionReq) (*TransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) CancelTransaction(context.Context, *CancelTransactionReq) (*CancelTransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTransactionRecord(context.Context, *GetTransactionRecordReq) (*GetTransactionRecordRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionRecord not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTransactionRecords(context.Context, *GetTransactionRecordsReq) (*GetTransactionRecordsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionRecords not implemented")
+}
+func (UnimplementedWalletServiceServer) GetBalanceAt(context.Context, *GetBalanceAtReq) (*GetBalanceAtRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceAt not implemented")
+}
+func (UnimplementedWalletServiceServer) GetBalanceSnapshots(context.Context, *GetBalanceSnapshotsReq) (*GetBalanceSnapshotsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSnapshots not implemented")
+}
+func (UnimplementedWalletServiceServer) SubscribeWalletEvents(*SubscribeWalletEventsReq, WalletService_SubscribeWalletEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWalletEvents not implemented")
+}
+func (UnimplementedWalletServiceServer) Reconcile(context.Context, *ReconcileReq) (*ReconcileRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedWalletServiceServer) GetReconciliationDiscrepancies(context.Context, *GetReconciliationDiscrepanciesReq) (*GetReconciliationDiscrepanciesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationDiscrepancies not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateBonusCampaign(context.Context, *CreateBonusCampaignReq) (*CreateBonusCampaignRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBonusCampaign not implemented")
+}
+func (UnimplementedWalletServiceServer) GetBonusCampaigns(context.Context, *GetBonusCampaignsReq) (*GetBonusCampaignsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBonusCampaigns not implemented")
+}
+func (UnimplementedWalletServiceServer) GrantCampaignBonus(context.Context, *GrantCampaignBonusReq) (*TransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCampaignBonus not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateWebhook(context.Context, *CreateWebhookReq) (*CreateWebhookRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWebhooks(context.Context, *GetWebhooksReq) (*GetWebhooksRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedWalletServiceServer) SetWebhookEnabled(context.Context, *SetWebhookEnabledReq) (*SetWebhookEnabledRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWebhookEnabled not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesReq) (*GetWebhookDeliveriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+
+// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletServiceServer will
+// result in compilation errors.
+type UnsafeWalletServiceServer interface {
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
+	s.RegisterService(&WalletService_ServiceDesc, srv)
+}
+
+func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateWallet(ctx, req.(*CreateWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetWallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWallets(ctx, req.(*GetWalletsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeleteWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeleteWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/DeleteWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeleteWallet(ctx, req.(*DeleteWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetWalletStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWalletStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetWalletStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/SetWalletStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetWalletStatus(ctx, req.(*SetWalletStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CloseMemberWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMemberWalletsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CloseMemberWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/CloseMemberWallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CloseMemberWallets(ctx, req.(*CloseMemberWalletsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/Transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Transaction(ctx, req.(*TransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RollbackTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RollbackTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/RollbackTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RollbackTransaction(ctx, req.(*RollbackTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Transfer(ctx, req.(*TransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Exchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Exchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/Exchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Exchange(ctx, req.(*ExchangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetMemberEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberEquityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetMemberEquity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetMemberEquity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetMemberEquity(ctx, req.(*GetMemberEquityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PrepareTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PrepareTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/PrepareTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PrepareTransaction(ctx, req.(*PrepareTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ConfirmTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ConfirmTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/ConfirmTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ConfirmTransaction(ctx, req.(*ConfirmTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CancelTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CancelTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/CancelTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CancelTransaction(ctx, req.(*CancelTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransactionRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRecordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransactionRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetTransactionRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransactionRecord(ctx, req.(*GetTransactionRecordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTransactionRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransactionRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetTransactionRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransactionRecords(ctx, req.(*GetTransactionRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceAtReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetBalanceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBalanceAt(ctx, req.(*GetBalanceAtReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetBalanceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBalanceSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetBalanceSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBalanceSnapshots(ctx, req.(*GetBalanceSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SubscribeWalletEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletEventsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).SubscribeWalletEvents(m, &walletServiceSubscribeWalletEventsServer{stream})
+}
+
+type WalletService_SubscribeWalletEventsServer interface {
+	Send(*WalletEvent) error
+	grpc.ServerStream
+}
+
+type walletServiceSubscribeWalletEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletServiceSubscribeWalletEventsServer) Send(m *WalletEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/Reconcile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Reconcile(ctx, req.(*ReconcileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetReconciliationDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationDiscrepanciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetReconciliationDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetReconciliationDiscrepancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetReconciliationDiscrepancies(ctx, req.(*GetReconciliationDiscrepanciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateBonusCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBonusCampaignReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateBonusCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/CreateBonusCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateBonusCampaign(ctx, req.(*CreateBonusCampaignReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetBonusCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBonusCampaignsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBonusCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetBonusCampaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBonusCampaigns(ctx, req.(*GetBonusCampaignsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GrantCampaignBonus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCampaignBonusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GrantCampaignBonus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GrantCampaignBonus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GrantCampaignBonus(ctx, req.(*GrantCampaignBonusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/CreateWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateWebhook(ctx, req.(*CreateWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWebhooks(ctx, req.(*GetWebhooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetWebhookEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWebhookEnabledReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetWebhookEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/SetWebhookEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetWebhookEnabled(ctx, req.(*SetWebhookEnabledReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wallet.WalletService/GetWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallet.WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWallet",
+			Handler:    _WalletService_CreateWallet_Handler,
+		},
+		{
+			MethodName: "GetWallets",
+			Handler:    _WalletService_GetWallets_Handler,
+		},
+		{
+			MethodName: "DeleteWallet",
+			Handler:    _WalletService_DeleteWallet_Handler,
+		},
+		{
+			MethodName: "SetWalletStatus",
+			Handler:    _WalletService_SetWalletStatus_Handler,
+		},
+		{
+			MethodName: "CloseMemberWallets",
+			Handler:    _WalletService_CloseMemberWallets_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _WalletService_Transaction_Handler,
+		},
+		{
+			MethodName: "RollbackTransaction",
+			Handler:    _WalletService_RollbackTransaction_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _WalletService_Transfer_Handler,
+		},
+		{
+			MethodName: "Exchange",
+			Handler:    _WalletService_Exchange_Handler,
+		},
+		{
+			MethodName: "GetMemberEquity",
+			Handler:    _WalletService_GetMemberEquity_Handler,
+		},
+		{
+			MethodName: "PrepareTransaction",
+			Handler:    _WalletService_PrepareTransaction_Handler,
+		},
+		{
+			MethodName: "ConfirmTransaction",
+			Handler:    _WalletService_ConfirmTransaction_Handler,
+		},
+		{
+			MethodName: "CancelTransaction",
+			Handler:    _WalletService_CancelTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionRecord",
+			Handler:    _WalletService_GetTransactionRecord_Handler,
+		},
+		{
+			MethodName: "GetTransactionRecords",
+			Handler:    _WalletService_GetTransactionRecords_Handler,
+		},
+		{
+			MethodName: "GetBalanceAt",
+			Handler:    _WalletService_GetBalanceAt_Handler,
+		},
+		{
+			MethodName: "GetBalanceSnapshots",
+			Handler:    _WalletService_GetBalanceSnapshots_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _WalletService_Reconcile_Handler,
+		},
+		{
+			MethodName: "GetReconciliationDiscrepancies",
+			Handler:    _WalletService_GetReconciliationDiscrepancies_Handler,
+		},
+		{
+			MethodName: "CreateBonusCampaign",
+			Handler:    _WalletService_CreateBonusCampaign_Handler,
+		},
+		{
+			MethodName: "GetBonusCampaigns",
+			Handler:    _WalletService_GetBonusCampaigns_Handler,
+		},
+		{
+			MethodName: "GrantCampaignBonus",
+			Handler:    _WalletService_GrantCampaignBonus_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WalletService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _WalletService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "SetWebhookEnabled",
+			Handler:    _WalletService_SetWebhookEnabled_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _WalletService_GetWebhookDeliveries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeWalletEvents",
+			Handler:       _WalletService_SubscribeWalletEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wallet/wallet.proto",
+}
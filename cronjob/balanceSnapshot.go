@@ -0,0 +1,26 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	// UTC, once late transactions of the day before are settled
+	balanceSnapshotAt          = "00:30"
+	balanceSnapshotMaxDuration = 2 * time.Hour
+)
+
+// takeBalanceSnapshots snapshots yesterday.
+func takeBalanceSnapshots(ctx context.Context) error {
+	_, err := wallet.New().TakeBalanceSnapshots(ctx, &wallet.TakeBalanceSnapshotsReq{
+		Date: time.Now().UTC().AddDate(0, 0, -1).Unix(),
+	})
+	return err
+}
+
+func balanceSnapshotKey() string {
+	return "takeBalanceSnapshots:" + time.Now().UTC().Format("2006-01-02")
+}
@@ -19,6 +19,13 @@ func Cron() {
 
 	scheduler := gocron.NewScheduler(time.UTC)
 
+	scheduler.Every(recoverPendingTransactionsInterval).Do(work, recoverPendingTransactions, recoverPendingTransactionsKey, recoverPendingTransactionsInterval)
+	scheduler.Every(1).Day().At(reconcileAt).Do(work, reconcile, reconcileKey, reconcileMaxDuration)
+	scheduler.Every(1).Day().At(interestAt).Do(work, interest, interestKey, interestMaxDuration)
+	scheduler.Every(1).Day().At(balanceSnapshotAt).Do(work, takeBalanceSnapshots, balanceSnapshotKey, balanceSnapshotMaxDuration)
+	scheduler.Every(relayOutboxInterval).Do(work, relayOutbox, relayOutboxKey, relayOutboxMaxDuration)
+	scheduler.Every(deliverWebhooksInterval).Do(work, deliverWebhooks, deliverWebhooksKey, deliverWebhooksMaxDuration)
+
 	// Start all the pending jobs
 	scheduler.StartAsync()
 
@@ -0,0 +1,36 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	// UTC, right after the day accrued
+	interestAt          = "00:10"
+	interestMaxDuration = time.Hour
+)
+
+// interest accrues yesterday, then capitalizes every month that has ended.
+func interest(ctx context.Context) error {
+	now := time.Now().UTC()
+	impl := wallet.New()
+
+	if _, err := impl.AccrueInterest(ctx, &wallet.AccrueInterestReq{
+		Date: now.AddDate(0, 0, -1).Unix(),
+	}); err != nil {
+		return err
+	}
+
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	_, err := impl.CapitalizeInterest(ctx, &wallet.CapitalizeInterestReq{
+		Before: monthStart.Unix(),
+	})
+	return err
+}
+
+func interestKey() string {
+	return "interest:" + time.Now().UTC().Format("2006-01-02")
+}
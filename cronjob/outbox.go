@@ -0,0 +1,22 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	relayOutboxInterval    = time.Second
+	relayOutboxMaxDuration = 30 * time.Second
+)
+
+func relayOutbox(ctx context.Context) error {
+	_, err := wallet.New().RelayOutbox(ctx, &wallet.RelayOutboxReq{})
+	return err
+}
+
+func relayOutboxKey() string {
+	return "relayOutbox:" + time.Now().Truncate(relayOutboxInterval).Format(time.RFC3339)
+}
@@ -0,0 +1,25 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	recoverPendingTransactionsInterval = 5 * time.Minute
+	// no live transaction stays pending this long
+	pendingTransactionTimeout = 10 * time.Minute
+)
+
+func recoverPendingTransactions(ctx context.Context) error {
+	_, err := wallet.New().RecoverPendingTransactions(ctx, &wallet.RecoverPendingTransactionsReq{
+		OlderThanSeconds: int64(pendingTransactionTimeout.Seconds()),
+	})
+	return err
+}
+
+func recoverPendingTransactionsKey() string {
+	return "recoverPendingTransactions:" + time.Now().Truncate(recoverPendingTransactionsInterval).Format(time.RFC3339)
+}
@@ -0,0 +1,24 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	walletGrpc "github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	// UTC, when transactions are fewest
+	reconcileAt          = "19:00"
+	reconcileMaxDuration = 3 * time.Hour
+)
+
+func reconcile(ctx context.Context) error {
+	_, err := wallet.New().Reconcile(ctx, &walletGrpc.ReconcileReq{})
+	return err
+}
+
+func reconcileKey() string {
+	return "reconcile:" + time.Now().UTC().Format("2006-01-02")
+}
@@ -0,0 +1,22 @@
+package cronjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
+)
+
+const (
+	deliverWebhooksInterval    = 5 * time.Second
+	deliverWebhooksMaxDuration = time.Minute
+)
+
+func deliverWebhooks(ctx context.Context) error {
+	_, err := wallet.New().DeliverWebhooks(ctx, &wallet.DeliverWebhooksReq{})
+	return err
+}
+
+func deliverWebhooksKey() string {
+	return "deliverWebhooks:" + time.Now().Truncate(deliverWebhooksInterval).Format(time.RFC3339)
+}
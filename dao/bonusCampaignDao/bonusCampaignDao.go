@@ -0,0 +1,156 @@
+package bonusCampaignDao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/pagination"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "bonus_campaign"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID       *uint64
+	Currency *string
+	ActiveAt *time.Time
+	// lock the row until the transaction ends
+	ForUpdate bool
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.BonusCampaignModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Get return a record as raw-data-form
+func Get(tx *gorm.DB, query *QueryModel) (*dbModels.BonusCampaignModel, error) {
+
+	result := &dbModels.BonusCampaignModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagination) ([]dbModels.BonusCampaignModel, *general.PaginationInfo, error) {
+
+	var rows []dbModels.BonusCampaignModel
+	var count int64 = 0
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Count(&count).
+		Order(table + ".id DESC").
+		Scopes(paginateChain(paginate)).
+		Scan(&rows).Error
+
+	offset, _ := pagination.GetOffsetAndLimit(paginate)
+	paginationInfo := pagination.SetPaginationDto(paginate.Page, paginate.PageSize, int32(count), int32(offset))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.BonusCampaignModel{}, paginationInfo, nil
+	}
+
+	if err != nil {
+		return []dbModels.BonusCampaignModel{}, nil, err
+	}
+
+	return rows, paginationInfo, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idEqualScope(query.ID)).
+			Scopes(currencyEqualScope(query.Currency)).
+			Scopes(activeAtScope(query.ActiveAt)).
+			Scopes(forUpdateScope(query.ForUpdate))
+	}
+}
+
+func paginateChain(paginate *general.Pagination) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		offset, limit := pagination.GetOffsetAndLimit(paginate)
+		return db.
+			Scopes(offsetScope(offset)).
+			Scopes(limitScope(limit))
+
+	}
+}
+
+func idEqualScope(id *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if id != nil {
+			return db.Where(table+".id = ?", *id)
+		}
+		return db
+	}
+}
+
+func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if currency != nil {
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
+
+func activeAtScope(activeAt *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if activeAt != nil {
+			return db.Where(table+".start_at <= ? AND "+table+".end_at > ?", *activeAt, *activeAt)
+		}
+		return db
+	}
+}
+
+func forUpdateScope(forUpdate bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if forUpdate {
+			return db.Clauses(clause.Locking{Strength: "UPDATE"})
+		}
+		return db
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
+
+func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if offset > 0 {
+			return db.Offset(offset)
+		}
+		return db
+	}
+}
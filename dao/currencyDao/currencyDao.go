@@ -0,0 +1,111 @@
+package currencyDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "currency"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	Code      []string
+	Enabled   *bool
+	Provision *bool
+}
+
+// NewOrReplace a row, replacing the currency of the same code
+func NewOrReplace(db *gorm.DB, model *dbModels.CurrencyModel) (int, error) {
+
+	err := db.Table(table).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"name", "decimals", "min_amount", "max_amount", "enabled", "provision", "starting_amount"}),
+		}).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Get return a record as raw-data-form
+func Get(tx *gorm.DB, query *QueryModel) (*dbModels.CurrencyModel, error) {
+
+	result := &dbModels.CurrencyModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Limit(1).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.CurrencyModel, error) {
+	result := make([]dbModels.CurrencyModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".code ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.CurrencyModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(codeInScope(query.Code)).
+			Scopes(enabledEqualScope(query.Enabled)).
+			Scopes(provisionEqualScope(query.Provision))
+	}
+}
+
+func codeInScope(code []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(code) > 0 {
+			return db.Where(table+".code IN ?", code)
+		}
+		return db
+	}
+}
+
+func enabledEqualScope(enabled *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if enabled != nil {
+			return db.Where(table+".enabled = ?", *enabled)
+		}
+		return db
+	}
+}
+
+func provisionEqualScope(provision *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if provision != nil {
+			return db.Where(table+".provision = ?", *provision)
+		}
+		return db
+	}
+}
@@ -0,0 +1,148 @@
+package interestAccrualDao
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "interest_accrual"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	WalletID          *uint64
+	Status            []dbModels.InterestAccrualStatus
+	AccrualDateBefore *time.Time
+}
+
+type UpdateModel struct {
+	Status              *dbModels.InterestAccrualStatus
+	TransactionRecordID *sql.NullInt64
+	Remainder           *decimal.Decimal
+}
+
+// NewsIfNotExist rows, ignore those of a wallet already accrued on the date
+func NewsIfNotExist(db *gorm.DB, m []*dbModels.InterestAccrualModel) (int, error) {
+
+	result := db.Table(table).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(m, 3000)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// Gets return records ordered by wallet and date
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.InterestAccrualModel, error) {
+	result := make([]dbModels.InterestAccrualModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".wallet_id ASC").
+		Order(table + ".accrual_date ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.InterestAccrualModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetLatest return the record of the latest date matching query
+func GetLatest(tx *gorm.DB, query *QueryModel) (*dbModels.InterestAccrualModel, error) {
+
+	result := &dbModels.InterestAccrualModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".accrual_date DESC").
+		Limit(1).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Modify a row if its status is still the same as model's
+func Modify(tx *gorm.DB, model *dbModels.InterestAccrualModel, update *UpdateModel) error {
+	attrs := map[string]interface{}{}
+	if update.Status != nil {
+		attrs["status"] = *update.Status
+	}
+	if update.TransactionRecordID != nil {
+		attrs["transaction_record_id"] = *update.TransactionRecordID
+	}
+	if update.Remainder != nil {
+		attrs["remainder"] = *update.Remainder
+	}
+
+	db := tx.Table(table).
+		Model(dbModels.InterestAccrualModel{}).
+		Where(table+".id = ? AND "+table+".status = ?", model.ID, model.Status).
+		Updates(attrs)
+
+	if db.Error != nil {
+		return db.Error
+	}
+	// no row matched, the status has been changed by someone else
+	if db.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(statusInScope(query.Status)).
+			Scopes(accrualDateBeforeScope(query.AccrualDateBefore))
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func statusInScope(status []dbModels.InterestAccrualStatus) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(status) > 0 {
+			return db.Where(table+".status IN ?", status)
+		}
+		return db
+	}
+}
+
+func accrualDateBeforeScope(accrualDateBefore *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if accrualDateBefore != nil {
+			return db.Where(table+".accrual_date < ?", *accrualDateBefore)
+		}
+		return db
+	}
+}
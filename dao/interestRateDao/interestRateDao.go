@@ -0,0 +1,73 @@
+package interestRateDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "interest_rate"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	Currency []string
+}
+
+// News rows
+func News(db *gorm.DB, m []*dbModels.InterestRateModel) (int, error) {
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(table).
+			CreateInBatches(m, 3000).Error
+		return err
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return len(m), nil
+}
+
+// Gets return records ordered by currency and tier
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.InterestRateModel, error) {
+	result := make([]dbModels.InterestRateModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".currency ASC").
+		Order(table + ".min_balance ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.InterestRateModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func Delete(db *gorm.DB, query *QueryModel) error {
+	return db.Table(table).
+		Scopes(queryChain(query)).
+		Delete(&dbModels.InterestRateModel{}).Error
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(currencyInScope(query.Currency))
+	}
+}
+
+func currencyInScope(currency []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(currency) > 0 {
+			return db.Where(table+".currency IN ?", currency)
+		}
+		return db
+	}
+}
@@ -0,0 +1,73 @@
+package journalEntryDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "journal_entry"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID        *uint64
+	Reference *string
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.JournalEntryModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.JournalEntryModel, error) {
+	result := make([]dbModels.JournalEntryModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.JournalEntryModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idEqualScope(query.ID)).
+			Scopes(referenceEqualScope(query.Reference))
+	}
+}
+
+func idEqualScope(id *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if id != nil {
+			return db.Where(table+".id = ?", *id)
+		}
+		return db
+	}
+}
+
+func referenceEqualScope(reference *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if reference != nil {
+			return db.Where(table+".reference = ?", *reference)
+		}
+		return db
+	}
+}
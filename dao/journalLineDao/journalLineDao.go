@@ -0,0 +1,156 @@
+package journalLineDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+
+	"gorm.io/gorm"
+)
+
+const table = "journal_line"
+const accountTable = "ledger_account"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	JournalEntryID      *uint64
+	AccountID           []uint64
+	AccountType         *dbModels.LedgerAccountType
+	TransactionRecordID *uint64
+	Currency            *string
+}
+
+// SumModel is the total debit and credit of an account
+type SumModel struct {
+	AccountID uint64                     `gorm:"column:account_id"`
+	Code      string                     `gorm:"column:code"`
+	Type      dbModels.LedgerAccountType `gorm:"column:type"`
+	Debit     decimal.Decimal            `gorm:"column:debit"`
+	Credit    decimal.Decimal            `gorm:"column:credit"`
+}
+
+// New rows
+func News(db *gorm.DB, m []*dbModels.JournalLineModel) (int, error) {
+
+	err := db.Table(table).
+		CreateInBatches(m, 3000).Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(m), nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.JournalLineModel, error) {
+	result := make([]dbModels.JournalLineModel, 0)
+	err := tx.Table(table).
+		Select(table + ".*").
+		Scopes(queryChain(query)).
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.JournalLineModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SumByAccount return total debit and credit of every account matching query
+func SumByAccount(tx *gorm.DB, query *QueryModel) ([]SumModel, error) {
+	result := make([]SumModel, 0)
+	err := tx.Table(table).
+		Select(table + ".account_id, " + accountTable + ".code, " + accountTable + ".type, " +
+			"SUM(" + table + ".debit) AS debit, SUM(" + table + ".credit) AS credit").
+		Scopes(queryChain(query)).
+		Group(table + ".account_id, " + accountTable + ".code, " + accountTable + ".type").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []SumModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Sum return total debit and credit of all accounts matching query
+func Sum(tx *gorm.DB, query *QueryModel) (*SumModel, error) {
+	result := &SumModel{}
+	err := tx.Table(table).
+		Select("COALESCE(SUM(" + table + ".debit), 0) AS debit, COALESCE(SUM(" + table + ".credit), 0) AS credit").
+		Scopes(queryChain(query)).
+		Scan(result).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Joins("JOIN " + accountTable + " ON " + accountTable + ".id = " + table + ".account_id").
+			Scopes(journalEntryIDEqualScope(query.JournalEntryID)).
+			Scopes(accountIDInScope(query.AccountID)).
+			Scopes(accountTypeEqualScope(query.AccountType)).
+			Scopes(transactionRecordIDEqualScope(query.TransactionRecordID)).
+			Scopes(currencyEqualScope(query.Currency))
+	}
+}
+
+func journalEntryIDEqualScope(journalEntryID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if journalEntryID != nil {
+			return db.Where(table+".journal_entry_id = ?", *journalEntryID)
+		}
+		return db
+	}
+}
+
+func accountIDInScope(accountID []uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(accountID) > 0 {
+			return db.Where(table+".account_id IN ?", accountID)
+		}
+		return db
+	}
+}
+
+func accountTypeEqualScope(accountType *dbModels.LedgerAccountType) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if accountType != nil {
+			return db.Where(accountTable+".type = ?", *accountType)
+		}
+		return db
+	}
+}
+
+func transactionRecordIDEqualScope(transactionRecordID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if transactionRecordID != nil {
+			return db.Where(table+".transaction_record_id = ?", *transactionRecordID)
+		}
+		return db
+	}
+}
+
+func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if currency != nil {
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
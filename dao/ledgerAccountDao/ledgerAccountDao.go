@@ -0,0 +1,152 @@
+package ledgerAccountDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "ledger_account"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID       []uint64
+	Code     *string
+	Type     *dbModels.LedgerAccountType
+	WalletID *uint64
+	Currency *string
+	ForShare bool
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.LedgerAccountModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// NewIfNotExist a row, ignore it if the code of the currency already exists
+func NewIfNotExist(db *gorm.DB, model *dbModels.LedgerAccountModel) (int, error) {
+
+	result := db.Table(table).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// Get return a record as raw-data-form
+func Get(tx *gorm.DB, query *QueryModel) (*dbModels.LedgerAccountModel, error) {
+
+	result := &dbModels.LedgerAccountModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.LedgerAccountModel, error) {
+	result := make([]dbModels.LedgerAccountModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.LedgerAccountModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idInScope(query.ID)).
+			Scopes(codeEqualScope(query.Code)).
+			Scopes(typeEqualScope(query.Type)).
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(currencyEqualScope(query.Currency)).
+			Scopes(forShareScope(query.ForShare))
+	}
+}
+
+func idInScope(id []uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(id) > 0 {
+			return db.Where(table+".id IN ?", id)
+		}
+		return db
+	}
+}
+
+func codeEqualScope(code *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if code != nil {
+			return db.Where(table+".code = ?", *code)
+		}
+		return db
+	}
+}
+
+func typeEqualScope(accountType *dbModels.LedgerAccountType) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if accountType != nil {
+			return db.Where(table+".type = ?", *accountType)
+		}
+		return db
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if currency != nil {
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
+
+func forShareScope(forShare bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if forShare {
+			return db.Clauses(clause.Locking{Strength: "SHARE"})
+		}
+		return db
+	}
+}
@@ -0,0 +1,85 @@
+package outboxDao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "outbox"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	Published *bool
+	Limit     int
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.OutboxModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Gets return records as raw-data-form, oldest first
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.OutboxModel, error) {
+	result := make([]dbModels.OutboxModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".id ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.OutboxModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// MarkPublished sets the publish time of the rows not published yet
+func MarkPublished(tx *gorm.DB, id []uint64, publishedAt time.Time) error {
+	return tx.Table(table).
+		Where(table+".id IN ? AND "+table+".published_at IS NULL", id).
+		Update("published_at", publishedAt).Error
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(publishedScope(query.Published)).
+			Scopes(limitScope(query.Limit))
+	}
+}
+
+func publishedScope(published *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if published == nil {
+			return db
+		}
+		if *published {
+			return db.Where(table + ".published_at IS NOT NULL")
+		}
+		return db.Where(table + ".published_at IS NULL")
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
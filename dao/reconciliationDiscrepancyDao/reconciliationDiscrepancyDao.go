@@ -0,0 +1,148 @@
+package reconciliationDiscrepancyDao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/pagination"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "reconciliation_discrepancy"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	RunID       *string
+	MemberID    *uint64
+	WalletID    *uint64
+	Type        []dbModels.ReconciliationDiscrepancyType
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// News rows
+func News(db *gorm.DB, m []*dbModels.ReconciliationDiscrepancyModel) (int, error) {
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(table).
+			CreateInBatches(m, 3000).Error
+		return err
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return len(m), nil
+}
+
+func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagination) ([]dbModels.ReconciliationDiscrepancyModel, *general.PaginationInfo, error) {
+
+	var rows []dbModels.ReconciliationDiscrepancyModel
+	var count int64 = 0
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Count(&count).
+		Order(table + ".id DESC").
+		Scopes(paginateChain(paginate)).
+		Scan(&rows).Error
+
+	offset, _ := pagination.GetOffsetAndLimit(paginate)
+	paginationInfo := pagination.SetPaginationDto(paginate.Page, paginate.PageSize, int32(count), int32(offset))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.ReconciliationDiscrepancyModel{}, paginationInfo, nil
+	}
+
+	if err != nil {
+		return []dbModels.ReconciliationDiscrepancyModel{}, nil, err
+	}
+
+	return rows, paginationInfo, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(runIDEqualScope(query.RunID)).
+			Scopes(memberIDEqualScope(query.MemberID)).
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(typeInScope(query.Type)).
+			Scopes(createdBetweenScope(query.CreatedFrom, query.CreatedTo))
+	}
+}
+
+func paginateChain(paginate *general.Pagination) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		offset, limit := pagination.GetOffsetAndLimit(paginate)
+		return db.
+			Scopes(offsetScope(offset)).
+			Scopes(limitScope(limit))
+
+	}
+}
+
+func runIDEqualScope(runID *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if runID != nil {
+			return db.Where(table+".run_id = ?", *runID)
+		}
+		return db
+	}
+}
+
+func memberIDEqualScope(memberID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if memberID != nil {
+			return db.Where(table+".member_id = ?", *memberID)
+		}
+		return db
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func typeInScope(discrepancyType []dbModels.ReconciliationDiscrepancyType) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(discrepancyType) > 0 {
+			return db.Where(table+".type IN ?", discrepancyType)
+		}
+		return db
+	}
+}
+
+func createdBetweenScope(createdFrom, createdTo *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if createdFrom != nil && createdTo != nil {
+			return db.Where(table+".created_at BETWEEN ? AND ?", *createdFrom, *createdTo)
+		}
+		return db
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
+
+func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if offset > 0 {
+			return db.Offset(offset)
+		}
+		return db
+	}
+}
@@ -0,0 +1,109 @@
+package transactionLimitDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "transaction_limit"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID       *uint64
+	Currency *string
+	Action   []dbModels.TransactionAction
+	WalletID []uint64
+}
+
+// NewOrReplace a row, replacing the limits of the same currency, action and
+// wallet
+func NewOrReplace(db *gorm.DB, model *dbModels.TransactionLimitModel) (int, error) {
+
+	err := db.Table(table).
+		Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"max_amount", "daily_amount", "monthly_amount", "daily_count", "monthly_count"}),
+		}).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.TransactionLimitModel, error) {
+	result := make([]dbModels.TransactionLimitModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".currency ASC").
+		Order(table + ".action ASC").
+		Order(table + ".wallet_id ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.TransactionLimitModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func Delete(db *gorm.DB, query *QueryModel) error {
+	return db.Table(table).
+		Scopes(queryChain(query)).
+		Delete(&dbModels.TransactionLimitModel{}).Error
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idEqualScope(query.ID)).
+			Scopes(currencyEqualScope(query.Currency)).
+			Scopes(actionInScope(query.Action)).
+			Scopes(walletIDInScope(query.WalletID))
+	}
+}
+
+func idEqualScope(id *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if id != nil {
+			return db.Where(table+".id = ?", *id)
+		}
+		return db
+	}
+}
+
+func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if currency != nil {
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
+
+func actionInScope(action []dbModels.TransactionAction) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(action) > 0 {
+			return db.Where(table+".action IN ?", action)
+		}
+		return db
+	}
+}
+
+func walletIDInScope(walletID []uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(walletID) > 0 {
+			return db.Where(table+".wallet_id IN ?", walletID)
+		}
+		return db
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const table = "transaction_record"
@@ -23,6 +24,11 @@ const (
 	OrderColumn_CommitterID
 	OrderColumn_Currency
 	OrderColumn_CreatedAt
+	OrderColumn_AppliedAt
+	OrderColumn_RolledBackAt
+	OrderColumn_WalletVersion
+	OrderColumn_RollbackWalletVersion
+	OrderColumn_ID
 )
 
 type OrderDirection int
@@ -40,26 +46,47 @@ type Order struct {
 
 // QueryModel set query condition, used by queryChain()
 type QueryModel struct {
-	ID           *uint64
-	MemberID     *uint64
-	CommitterID  *uint64
-	RollbackerID *uint64
-	Currency     []string
-	Action       []dbModels.TransactionAction
-	Status       []dbModels.TransactionStatus
-	CreatedFrom  *time.Time
-	CreatedTo    *time.Time
-	OrderBy      []*Order
+	ID                     *uint64
+	MemberID               *uint64
+	WalletID               *uint64
+	CommitterID            *uint64
+	RollbackerID           *uint64
+	TransferID             *string
+	CampaignID             *uint64
+	IdempotencyKey         *string
+	RollbackIdempotencyKey *string
+	Currency               []string
+	Action                 []dbModels.TransactionAction
+	Status                 []dbModels.TransactionStatus
+	CreatedFrom            *time.Time
+	CreatedTo              *time.Time
+	CreatedBefore          *time.Time
+	Prepared               *bool
+	PreparedBefore         *time.Time
+	AppliedFrom            *time.Time
+	AppliedBefore          *time.Time
+	AppliedUntil           *time.Time
+	RolledBackFrom         *time.Time
+	RolledBackBefore       *time.Time
+	RolledBackUntil        *time.Time
+	OrderBy                []*Order
+	ForShare               bool
 }
 
 type UpdateModel struct {
-	BeforeAmount         *decimal.NullDecimal
-	AfterAmount          *decimal.NullDecimal
-	Status               *dbModels.TransactionStatus
-	Remark               *sql.NullString
-	RollbackBeforeAmount *decimal.NullDecimal
-	RollbackAfterAmount  *decimal.NullDecimal
-	RollbackerID         *sql.NullInt64
+	BeforeAmount           *decimal.NullDecimal
+	AfterAmount            *decimal.NullDecimal
+	Status                 *dbModels.TransactionStatus
+	Remark                 *sql.NullString
+	RollbackBeforeAmount   *decimal.NullDecimal
+	RollbackAfterAmount    *decimal.NullDecimal
+	RollbackerID           *sql.NullInt64
+	IdempotencyKey         *sql.NullString
+	RollbackIdempotencyKey *sql.NullString
+	WalletVersion          *sql.NullInt64
+	RollbackWalletVersion  *sql.NullInt64
+	AppliedAt              *sql.NullTime
+	RolledBackAt           *sql.NullTime
 }
 
 // New a row
@@ -99,16 +126,21 @@ func News(db *gorm.DB, m []*dbModels.TransactionRecordModel) (int, error) {
 func Get(tx *gorm.DB, query *QueryModel) (*dbModels.TransactionRecordModel, error) {
 
 	result := &dbModels.TransactionRecordModel{}
-	err := tx.Table(table).
+	db := tx.Table(table).
 		Scopes(queryChain(query)).
-		Scan(result).Error
+		Limit(1).
+		Scan(result)
 
+	err := db.Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
 	return result, nil
 }
 
@@ -154,7 +186,72 @@ func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagina
 	return rows, paginationInfo, nil
 }
 
-// Gets return records as raw-data-form
+// SumAmount return the total amount of records matching query
+func SumAmount(tx *gorm.DB, query *QueryModel) (decimal.Decimal, error) {
+	result := struct {
+		Amount decimal.Decimal
+	}{}
+	err := tx.Table(table).
+		Select("COALESCE(SUM(" + table + ".amount), 0) AS amount").
+		Scopes(queryChain(query)).
+		Scan(&result).Error
+
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return result.Amount, nil
+}
+
+type VolumeModel struct {
+	Amount decimal.Decimal
+	Count  int64
+}
+
+// SumVolume return the total absolute amount and the number of records
+// matching query
+func SumVolume(tx *gorm.DB, query *QueryModel) (*VolumeModel, error) {
+	result := &VolumeModel{}
+	err := tx.Table(table).
+		Select("COALESCE(SUM(ABS(" + table + ".amount)), 0) AS amount, COUNT(*) AS count").
+		Scopes(queryChain(query)).
+		Scan(result).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+type ActionSumModel struct {
+	WalletID uint64
+	Action   dbModels.TransactionAction
+	Amount   decimal.Decimal
+}
+
+// SumAmountByWalletAction return the total amount of records matching query
+// for each wallet and action
+func SumAmountByWalletAction(tx *gorm.DB, query *QueryModel) ([]ActionSumModel, error) {
+	result := make([]ActionSumModel, 0)
+	err := tx.Table(table).
+		Select(table + ".wallet_id, " + table + ".action, SUM(" + table + ".amount) AS amount").
+		Scopes(queryChain(query)).
+		Group(table + ".wallet_id, " + table + ".action").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []ActionSumModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Modify a row if its status is still the same as model's
 func Modify(tx *gorm.DB, model *dbModels.TransactionRecordModel, update *UpdateModel) error {
 	attrs := map[string]interface{}{}
 	if update.BeforeAmount != nil {
@@ -178,13 +275,38 @@ func Modify(tx *gorm.DB, model *dbModels.TransactionRecordModel, update *UpdateM
 	if update.RollbackerID != nil {
 		attrs["rollbacker_id"] = *update.RollbackerID
 	}
+	if update.IdempotencyKey != nil {
+		attrs["idempotency_key"] = *update.IdempotencyKey
+	}
+	if update.RollbackIdempotencyKey != nil {
+		attrs["rollback_idempotency_key"] = *update.RollbackIdempotencyKey
+	}
+	if update.WalletVersion != nil {
+		attrs["wallet_version"] = *update.WalletVersion
+	}
+	if update.RollbackWalletVersion != nil {
+		attrs["rollback_wallet_version"] = *update.RollbackWalletVersion
+	}
+	if update.AppliedAt != nil {
+		attrs["applied_at"] = *update.AppliedAt
+	}
+	if update.RolledBackAt != nil {
+		attrs["rolled_back_at"] = *update.RolledBackAt
+	}
 
-	err := tx.Table(table).
+	db := tx.Table(table).
 		Model(dbModels.TransactionRecordModel{}).
 		Where(table+".id = ? AND "+table+".status = ?", model.ID, model.Status).
-		Updates(attrs).Error
+		Updates(attrs)
 
-	return err
+	if db.Error != nil {
+		return db.Error
+	}
+	// no row matched, the status has been changed by someone else
+	if db.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
 func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
@@ -192,13 +314,28 @@ func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
 		return db.
 			Scopes(idEqualScope(query.ID)).
 			Scopes(memberIDEqualScope(query.MemberID)).
+			Scopes(walletIDEqualScope(query.WalletID)).
 			Scopes(committerIDEqualScope(query.CommitterID)).
 			Scopes(rollbackerIDEqualScope(query.RollbackerID)).
+			Scopes(transferIDEqualScope(query.TransferID)).
+			Scopes(campaignIDEqualScope(query.CampaignID)).
+			Scopes(idempotencyKeyEqualScope(query.IdempotencyKey)).
+			Scopes(rollbackIdempotencyKeyEqualScope(query.RollbackIdempotencyKey)).
 			Scopes(currencyInScope(query.Currency)).
 			Scopes(statusInScope(query.Status)).
 			Scopes(actionInScope(query.Action)).
 			Scopes(createdBetweenScope(query.CreatedFrom, query.CreatedTo)).
-			Scopes(orderByScope(query.OrderBy))
+			Scopes(createdBeforeScope(query.CreatedBefore)).
+			Scopes(preparedScope(query.Prepared)).
+			Scopes(preparedBeforeScope(query.PreparedBefore)).
+			Scopes(appliedFromScope(query.AppliedFrom)).
+			Scopes(appliedBeforeScope(query.AppliedBefore)).
+			Scopes(appliedUntilScope(query.AppliedUntil)).
+			Scopes(rolledBackFromScope(query.RolledBackFrom)).
+			Scopes(rolledBackBeforeScope(query.RolledBackBefore)).
+			Scopes(rolledBackUntilScope(query.RolledBackUntil)).
+			Scopes(orderByScope(query.OrderBy)).
+			Scopes(forShareScope(query.ForShare))
 	}
 }
 
@@ -230,6 +367,15 @@ func memberIDEqualScope(memberID *uint64) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
 func committerIDEqualScope(committerID *uint64) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if committerID != nil {
@@ -248,6 +394,42 @@ func rollbackerIDEqualScope(committerID *uint64) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+func transferIDEqualScope(transferID *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if transferID != nil {
+			return db.Where(table+".transfer_id = ?", *transferID)
+		}
+		return db
+	}
+}
+
+func campaignIDEqualScope(campaignID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if campaignID != nil {
+			return db.Where(table+".campaign_id = ?", *campaignID)
+		}
+		return db
+	}
+}
+
+func idempotencyKeyEqualScope(idempotencyKey *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if idempotencyKey != nil {
+			return db.Where(table+".idempotency_key = ?", *idempotencyKey)
+		}
+		return db
+	}
+}
+
+func rollbackIdempotencyKeyEqualScope(rollbackIdempotencyKey *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if rollbackIdempotencyKey != nil {
+			return db.Where(table+".rollback_idempotency_key = ?", *rollbackIdempotencyKey)
+		}
+		return db
+	}
+}
+
 func currencyInScope(currency []string) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if len(currency) > 0 {
@@ -284,6 +466,90 @@ func createdBetweenScope(createdFrom, createdTo *time.Time) func(db *gorm.DB) *g
 	}
 }
 
+func createdBeforeScope(createdBefore *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if createdBefore != nil {
+			return db.Where(table+".created_at < ?", *createdBefore)
+		}
+		return db
+	}
+}
+
+func preparedScope(prepared *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if prepared != nil {
+			if *prepared {
+				return db.Where(table + ".prepared_until IS NOT NULL")
+			}
+			return db.Where(table + ".prepared_until IS NULL")
+		}
+		return db
+	}
+}
+
+func preparedBeforeScope(preparedBefore *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if preparedBefore != nil {
+			return db.Where(table+".prepared_until < ?", *preparedBefore)
+		}
+		return db
+	}
+}
+
+func appliedFromScope(appliedFrom *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if appliedFrom != nil {
+			return db.Where(table+".applied_at >= ?", *appliedFrom)
+		}
+		return db
+	}
+}
+
+func appliedBeforeScope(appliedBefore *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if appliedBefore != nil {
+			return db.Where(table+".applied_at < ?", *appliedBefore)
+		}
+		return db
+	}
+}
+
+func appliedUntilScope(appliedUntil *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if appliedUntil != nil {
+			return db.Where(table+".applied_at <= ?", *appliedUntil)
+		}
+		return db
+	}
+}
+
+func rolledBackFromScope(rolledBackFrom *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if rolledBackFrom != nil {
+			return db.Where(table+".rolled_back_at >= ?", *rolledBackFrom)
+		}
+		return db
+	}
+}
+
+func rolledBackBeforeScope(rolledBackBefore *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if rolledBackBefore != nil {
+			return db.Where(table+".rolled_back_at < ?", *rolledBackBefore)
+		}
+		return db
+	}
+}
+
+func rolledBackUntilScope(rolledBackUntil *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if rolledBackUntil != nil {
+			return db.Where(table+".rolled_back_at <= ?", *rolledBackUntil)
+		}
+		return db
+	}
+}
+
 func orderByScope(order []*Order) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if len(order) > 0 {
@@ -298,6 +564,16 @@ func orderByScope(order []*Order) func(db *gorm.DB) *gorm.DB {
 					orderClause += "currency"
 				case OrderColumn_CreatedAt:
 					orderClause += "created_at"
+				case OrderColumn_AppliedAt:
+					orderClause += "applied_at"
+				case OrderColumn_RolledBackAt:
+					orderClause += "rolled_back_at"
+				case OrderColumn_WalletVersion:
+					orderClause += "wallet_version"
+				case OrderColumn_RollbackWalletVersion:
+					orderClause += "rollback_wallet_version"
+				case OrderColumn_ID:
+					orderClause += "id"
 				default:
 					continue
 				}
@@ -317,6 +593,15 @@ func orderByScope(order []*Order) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+func forShareScope(forShare bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if forShare {
+			return db.Clauses(clause.Locking{Strength: "SHARE"})
+		}
+		return db
+	}
+}
+
 func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if limit > 0 {
@@ -329,7 +614,7 @@ func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
 func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if offset > 0 {
-			return db.Limit(offset)
+			return db.Offset(offset)
 		}
 		return db
 	}
@@ -0,0 +1,147 @@
+package walletBalanceSnapshotDao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/pagination"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "wallet_balance_snapshot"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	MemberID *uint64
+	WalletID *uint64
+	Currency *string
+	DateFrom *time.Time
+	DateTo   *time.Time
+}
+
+// NewsIfNotExist rows, ignore those of a wallet already taken on the date
+func NewsIfNotExist(db *gorm.DB, m []*dbModels.WalletBalanceSnapshotModel) (int, error) {
+
+	result := db.Table(table).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(m, 3000)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagination) ([]dbModels.WalletBalanceSnapshotModel, *general.PaginationInfo, error) {
+
+	var rows []dbModels.WalletBalanceSnapshotModel
+	var count int64 = 0
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Count(&count).
+		Order(table + ".snapshot_date ASC").
+		Order(table + ".wallet_id ASC").
+		Scopes(paginateChain(paginate)).
+		Scan(&rows).Error
+
+	offset, _ := pagination.GetOffsetAndLimit(paginate)
+	paginationInfo := pagination.SetPaginationDto(paginate.Page, paginate.PageSize, int32(count), int32(offset))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WalletBalanceSnapshotModel{}, paginationInfo, nil
+	}
+
+	if err != nil {
+		return []dbModels.WalletBalanceSnapshotModel{}, nil, err
+	}
+
+	return rows, paginationInfo, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(memberIDEqualScope(query.MemberID)).
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(currencyEqualScope(query.Currency)).
+			Scopes(dateFromScope(query.DateFrom)).
+			Scopes(dateToScope(query.DateTo))
+	}
+}
+
+func paginateChain(paginate *general.Pagination) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		offset, limit := pagination.GetOffsetAndLimit(paginate)
+		return db.
+			Scopes(offsetScope(offset)).
+			Scopes(limitScope(limit))
+
+	}
+}
+
+func memberIDEqualScope(memberID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if memberID != nil {
+			return db.Where(table+".member_id = ?", *memberID)
+		}
+		return db
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if currency != nil {
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
+
+func dateFromScope(dateFrom *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if dateFrom != nil {
+			return db.Where(table+".snapshot_date >= ?", dateFrom.Format("2006-01-02"))
+		}
+		return db
+	}
+}
+
+func dateToScope(dateTo *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if dateTo != nil {
+			return db.Where(table+".snapshot_date <= ?", dateTo.Format("2006-01-02"))
+		}
+		return db
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
+
+func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if offset > 0 {
+			return db.Offset(offset)
+		}
+		return db
+	}
+}
@@ -1,6 +1,7 @@
 package walletDao
 
 import (
+	"database/sql"
 	"errors"
 
 	"github.com/paper-trade-chatbot/be-common/pagination"
@@ -9,6 +10,7 @@ import (
 	"github.com/shopspring/decimal"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const table = "wallet"
@@ -19,10 +21,17 @@ type QueryModel struct {
 	MemberID []uint64
 	Currency *string
 	Amount   *decimal.Decimal
+	Negative *bool
+	// lock the row until the transaction ends
+	ForUpdate bool
 }
 
 type UpdateModel struct {
-	Amount *decimal.Decimal
+	Amount        *decimal.Decimal
+	HeldAmount    *decimal.Decimal
+	Status        *dbModels.WalletStatus
+	CreditLimit   *decimal.Decimal
+	NegativeSince *sql.NullTime
 }
 
 // New a row
@@ -37,6 +46,19 @@ func New(db *gorm.DB, model *dbModels.WalletModel) (int, error) {
 	return 1, nil
 }
 
+// NewIfNotExist a row, ignore it if the member already has a wallet of the currency
+func NewIfNotExist(db *gorm.DB, model *dbModels.WalletModel) (int, error) {
+
+	result := db.Table(table).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(model)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
 // New rows
 func News(db *gorm.DB, m []*dbModels.WalletModel) (int, error) {
 
@@ -62,16 +84,20 @@ func News(db *gorm.DB, m []*dbModels.WalletModel) (int, error) {
 func Get(tx *gorm.DB, query *QueryModel) (*dbModels.WalletModel, error) {
 
 	result := &dbModels.WalletModel{}
-	err := tx.Table(table).
+	db := tx.Table(table).
 		Scopes(queryChain(query)).
-		Scan(result).Error
+		Scan(result)
 
+	err := db.Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
 	return result, nil
 }
 
@@ -117,18 +143,39 @@ func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagina
 	return rows, paginationInfo, nil
 }
 
-// Modify a row
+// Modify a row if its version is still the same as model's
 func Modify(tx *gorm.DB, model *dbModels.WalletModel, update *UpdateModel) error {
-	attrs := map[string]interface{}{
-		"amount": update.Amount,
+	attrs := map[string]interface{}{}
+	if update.Amount != nil {
+		attrs["amount"] = *update.Amount
+	}
+	if update.HeldAmount != nil {
+		attrs["held_amount"] = *update.HeldAmount
+	}
+	if update.Status != nil {
+		attrs["status"] = *update.Status
+	}
+	if update.CreditLimit != nil {
+		attrs["credit_limit"] = *update.CreditLimit
 	}
+	if update.NegativeSince != nil {
+		attrs["negative_since"] = *update.NegativeSince
+	}
+	attrs["version"] = gorm.Expr(table + ".version + 1")
 
-	err := tx.Table(table).
+	db := tx.Table(table).
 		Model(dbModels.WalletModel{}).
-		Where(table+".id = ? AND "+table+".amount = ?", model.ID, model.Amount).
-		Updates(attrs).Error
+		Where(table+".id = ? AND "+table+".version = ?", model.ID, model.Version).
+		Updates(attrs)
 
-	return err
+	if db.Error != nil {
+		return db.Error
+	}
+	// no row matched, the wallet has been modified by someone else
+	if db.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
 }
 
 func Delete(db *gorm.DB, query *QueryModel) error {
@@ -141,7 +188,10 @@ func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		return db.
 			Scopes(idInScope(query.ID)).
-			Scopes(memberIDInScope(query.MemberID))
+			Scopes(memberIDInScope(query.MemberID)).
+			Scopes(currencyEqualScope(query.Currency)).
+			Scopes(negativeScope(query.Negative)).
+			Scopes(forUpdateScope(query.ForUpdate))
 
 	}
 }
@@ -177,7 +227,28 @@ func memberIDInScope(memberID []uint64) func(db *gorm.DB) *gorm.DB {
 func currencyEqualScope(currency *string) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if currency != nil {
-			return db.Where(table+".currency = ?", currency)
+			return db.Where(table+".currency = ?", *currency)
+		}
+		return db
+	}
+}
+
+func negativeScope(negative *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if negative == nil {
+			return db
+		}
+		if *negative {
+			return db.Where(table + ".negative_since IS NOT NULL")
+		}
+		return db.Where(table + ".negative_since IS NULL")
+	}
+}
+
+func forUpdateScope(forUpdate bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if forUpdate {
+			return db.Clauses(clause.Locking{Strength: "UPDATE"})
 		}
 		return db
 	}
@@ -195,7 +266,7 @@ func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
 func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if offset > 0 {
-			return db.Limit(offset)
+			return db.Offset(offset)
 		}
 		return db
 	}
@@ -0,0 +1,147 @@
+package walletHoldDao
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "wallet_hold"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID                  *uint64
+	WalletID            *uint64
+	TransactionRecordID *uint64
+	Status              []dbModels.WalletHoldStatus
+}
+
+type UpdateModel struct {
+	Status              *dbModels.WalletHoldStatus
+	TransactionRecordID *sql.NullInt64
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.WalletHoldModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Get return a record as raw-data-form
+func Get(tx *gorm.DB, query *QueryModel) (*dbModels.WalletHoldModel, error) {
+
+	result := &dbModels.WalletHoldModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.WalletHoldModel, error) {
+	result := make([]dbModels.WalletHoldModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WalletHoldModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Modify a row if its status is still the same as model's
+func Modify(tx *gorm.DB, model *dbModels.WalletHoldModel, update *UpdateModel) error {
+	attrs := map[string]interface{}{}
+	if update.Status != nil {
+		attrs["status"] = *update.Status
+	}
+	if update.TransactionRecordID != nil {
+		attrs["transaction_record_id"] = *update.TransactionRecordID
+	}
+
+	db := tx.Table(table).
+		Model(dbModels.WalletHoldModel{}).
+		Where(table+".id = ? AND "+table+".status = ?", model.ID, model.Status).
+		Updates(attrs)
+
+	if db.Error != nil {
+		return db.Error
+	}
+	// no row matched, the status has been changed by someone else
+	if db.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idEqualScope(query.ID)).
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(transactionRecordIDEqualScope(query.TransactionRecordID)).
+			Scopes(statusInScope(query.Status))
+	}
+}
+
+func idEqualScope(id *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if id != nil {
+			return db.Where(table+".id = ?", *id)
+		}
+		return db
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func transactionRecordIDEqualScope(transactionRecordID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if transactionRecordID != nil {
+			return db.Where(table+".transaction_record_id = ?", *transactionRecordID)
+		}
+		return db
+	}
+}
+
+func statusInScope(status []dbModels.WalletHoldStatus) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(status) > 0 {
+			return db.Where(table+".status IN ?", status)
+		}
+		return db
+	}
+}
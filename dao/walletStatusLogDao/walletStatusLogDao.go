@@ -0,0 +1,121 @@
+package walletStatusLogDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-common/pagination"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "wallet_status_log"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	MemberID   *uint64
+	WalletID   *uint64
+	OperatorID *uint64
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.WalletStatusLogModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagination) ([]dbModels.WalletStatusLogModel, *general.PaginationInfo, error) {
+
+	var rows []dbModels.WalletStatusLogModel
+	var count int64 = 0
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Count(&count).
+		Order(table + ".id DESC").
+		Scopes(paginateChain(paginate)).
+		Scan(&rows).Error
+
+	offset, _ := pagination.GetOffsetAndLimit(paginate)
+	paginationInfo := pagination.SetPaginationDto(paginate.Page, paginate.PageSize, int32(count), int32(offset))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WalletStatusLogModel{}, paginationInfo, nil
+	}
+
+	if err != nil {
+		return []dbModels.WalletStatusLogModel{}, nil, err
+	}
+
+	return rows, paginationInfo, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(memberIDEqualScope(query.MemberID)).
+			Scopes(walletIDEqualScope(query.WalletID)).
+			Scopes(operatorIDEqualScope(query.OperatorID))
+	}
+}
+
+func paginateChain(paginate *general.Pagination) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		offset, limit := pagination.GetOffsetAndLimit(paginate)
+		return db.
+			Scopes(offsetScope(offset)).
+			Scopes(limitScope(limit))
+
+	}
+}
+
+func memberIDEqualScope(memberID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if memberID != nil {
+			return db.Where(table+".member_id = ?", *memberID)
+		}
+		return db
+	}
+}
+
+func walletIDEqualScope(walletID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if walletID != nil {
+			return db.Where(table+".wallet_id = ?", *walletID)
+		}
+		return db
+	}
+}
+
+func operatorIDEqualScope(operatorID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if operatorID != nil {
+			return db.Where(table+".operator_id = ?", *operatorID)
+		}
+		return db
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
+
+func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if offset > 0 {
+			return db.Offset(offset)
+		}
+		return db
+	}
+}
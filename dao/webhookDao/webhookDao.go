@@ -0,0 +1,130 @@
+package webhookDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "webhook"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID        []uint64
+	EventType *dbModels.WalletEventType
+	Enabled   *bool
+}
+
+// UpdateModel set the columns Modify() updates
+type UpdateModel struct {
+	Enabled *bool
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.WebhookModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Get return a record as raw-data-form
+func Get(tx *gorm.DB, query *QueryModel) (*dbModels.WebhookModel, error) {
+
+	result := &dbModels.WebhookModel{}
+	db := tx.Table(table).
+		Scopes(queryChain(query)).
+		Limit(1).
+		Scan(result)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// Gets return records as raw-data-form
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.WebhookModel, error) {
+	result := make([]dbModels.WebhookModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".id ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WebhookModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Modify a row
+func Modify(tx *gorm.DB, model *dbModels.WebhookModel, update *UpdateModel) error {
+	attrs := map[string]interface{}{}
+	if update.Enabled != nil {
+		attrs["enabled"] = *update.Enabled
+	}
+
+	db := tx.Table(table).
+		Model(dbModels.WebhookModel{}).
+		Where(table+".id = ?", model.ID).
+		Updates(attrs)
+
+	if db.Error != nil {
+		return db.Error
+	}
+	return nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idInScope(query.ID)).
+			Scopes(eventTypeEqualScope(query.EventType)).
+			Scopes(enabledEqualScope(query.Enabled))
+	}
+}
+
+func idInScope(id []uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(id) > 0 {
+			return db.Where(table+".id IN ?", id)
+		}
+		return db
+	}
+}
+
+func eventTypeEqualScope(eventType *dbModels.WalletEventType) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if eventType != nil {
+			return db.Where(table+".event_type = ?", *eventType)
+		}
+		return db
+	}
+}
+
+func enabledEqualScope(enabled *bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if enabled != nil {
+			return db.Where(table+".enabled = ?", *enabled)
+		}
+		return db
+	}
+}
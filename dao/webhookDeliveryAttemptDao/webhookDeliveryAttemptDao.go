@@ -0,0 +1,63 @@
+package webhookDeliveryAttemptDao
+
+import (
+	"errors"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+)
+
+const table = "webhook_delivery_attempt"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	DeliveryID []uint64
+}
+
+// New a row
+func New(db *gorm.DB, model *dbModels.WebhookDeliveryAttemptModel) (int, error) {
+
+	err := db.Table(table).
+		Create(model).Error
+
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+// Gets return records as raw-data-form, in the order they were attempted
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.WebhookDeliveryAttemptModel, error) {
+	result := make([]dbModels.WebhookDeliveryAttemptModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".id ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WebhookDeliveryAttemptModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(deliveryIDInScope(query.DeliveryID))
+	}
+}
+
+func deliveryIDInScope(deliveryID []uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(deliveryID) > 0 {
+			return db.Where(table+".delivery_id IN ?", deliveryID)
+		}
+		return db
+	}
+}
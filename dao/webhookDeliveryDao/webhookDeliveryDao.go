@@ -0,0 +1,196 @@
+package webhookDeliveryDao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/pagination"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const table = "webhook_delivery"
+
+// QueryModel set query condition, used by queryChain()
+type QueryModel struct {
+	ID        *uint64
+	WebhookID *uint64
+	Status    []dbModels.WebhookDeliveryStatus
+	// next attempt due at or before
+	DueAt *time.Time
+	Limit int
+}
+
+// UpdateModel set the columns Modify() updates
+type UpdateModel struct {
+	Status        *dbModels.WebhookDeliveryStatus
+	Attempts      *int
+	NextAttemptAt *time.Time
+	DeliveredAt   *time.Time
+}
+
+// NewsIfNotExist rows, ignore the ones already queued for the webhook and event
+func NewsIfNotExist(db *gorm.DB, m []*dbModels.WebhookDeliveryModel) (int, error) {
+
+	result := db.Table(table).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(m, 3000)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
+// Gets return records as raw-data-form, oldest first
+func Gets(tx *gorm.DB, query *QueryModel) ([]dbModels.WebhookDeliveryModel, error) {
+	result := make([]dbModels.WebhookDeliveryModel, 0)
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Order(table + ".id ASC").
+		Scan(&result).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WebhookDeliveryModel{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func GetsWithPagination(tx *gorm.DB, query *QueryModel, paginate *general.Pagination) ([]dbModels.WebhookDeliveryModel, *general.PaginationInfo, error) {
+
+	var rows []dbModels.WebhookDeliveryModel
+	var count int64 = 0
+	err := tx.Table(table).
+		Scopes(queryChain(query)).
+		Count(&count).
+		Order(table + ".id DESC").
+		Scopes(paginateChain(paginate)).
+		Scan(&rows).Error
+
+	offset, _ := pagination.GetOffsetAndLimit(paginate)
+	paginationInfo := pagination.SetPaginationDto(paginate.Page, paginate.PageSize, int32(count), int32(offset))
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return []dbModels.WebhookDeliveryModel{}, paginationInfo, nil
+	}
+
+	if err != nil {
+		return []dbModels.WebhookDeliveryModel{}, nil, err
+	}
+
+	return rows, paginationInfo, nil
+}
+
+// Modify a row if neither its status nor its attempts changed since model was read
+func Modify(tx *gorm.DB, model *dbModels.WebhookDeliveryModel, update *UpdateModel) error {
+	attrs := map[string]interface{}{}
+	if update.Status != nil {
+		attrs["status"] = *update.Status
+	}
+	if update.Attempts != nil {
+		attrs["attempts"] = *update.Attempts
+	}
+	if update.NextAttemptAt != nil {
+		attrs["next_attempt_at"] = *update.NextAttemptAt
+	}
+	if update.DeliveredAt != nil {
+		attrs["delivered_at"] = *update.DeliveredAt
+	}
+
+	db := tx.Table(table).
+		Model(dbModels.WebhookDeliveryModel{}).
+		Where(table+".id = ? AND "+table+".status = ? AND "+table+".attempts = ?", model.ID, model.Status, model.Attempts).
+		Updates(attrs)
+
+	if db.Error != nil {
+		return db.Error
+	}
+	// no row matched, the delivery has been attempted by someone else
+	if db.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func queryChain(query *QueryModel) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Scopes(idEqualScope(query.ID)).
+			Scopes(webhookIDEqualScope(query.WebhookID)).
+			Scopes(statusInScope(query.Status)).
+			Scopes(dueAtScope(query.DueAt)).
+			Scopes(limitScope(query.Limit))
+	}
+}
+
+func paginateChain(paginate *general.Pagination) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		offset, limit := pagination.GetOffsetAndLimit(paginate)
+		return db.
+			Scopes(offsetScope(offset)).
+			Scopes(limitScope(limit))
+
+	}
+}
+
+func idEqualScope(id *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if id != nil {
+			return db.Where(table+".id = ?", *id)
+		}
+		return db
+	}
+}
+
+func webhookIDEqualScope(webhookID *uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if webhookID != nil {
+			return db.Where(table+".webhook_id = ?", *webhookID)
+		}
+		return db
+	}
+}
+
+func statusInScope(status []dbModels.WebhookDeliveryStatus) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if len(status) > 0 {
+			return db.Where(table+".status IN ?", status)
+		}
+		return db
+	}
+}
+
+func dueAtScope(dueAt *time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if dueAt != nil {
+			return db.Where(table+".next_attempt_at <= ?", *dueAt)
+		}
+		return db
+	}
+}
+
+func limitScope(limit int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if limit > 0 {
+			return db.Limit(limit)
+		}
+		return db
+	}
+}
+
+func offsetScope(offset int) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if offset > 0 {
+			return db.Offset(offset)
+		}
+		return db
+	}
+}
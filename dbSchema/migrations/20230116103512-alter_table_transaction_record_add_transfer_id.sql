@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `transfer_id` VARCHAR(36) NULL DEFAULT NULL COMMENT '轉帳id' AFTER `rollbacker_id`,
+    ADD INDEX (`transfer_id`);
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP INDEX `transfer_id`,
+    DROP COLUMN `transfer_id`;
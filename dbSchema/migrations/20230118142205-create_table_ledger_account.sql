@@ -0,0 +1,21 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`ledger_account`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `code` VARCHAR(64) NOT NULL COMMENT '科目代碼',
+    `type` TINYINT(4) UNSIGNED NOT NULL COMMENT '科目類型 1:會員錢包 2:系統科目',
+    `wallet_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '錢包id',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建日期',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新日期',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`code`,`currency`),
+    INDEX (`wallet_id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '會計科目';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `ledger_account`;
@@ -0,0 +1,17 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`journal_entry`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `reference` VARCHAR(64) NOT NULL COMMENT '來源參照',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建日期',
+
+    PRIMARY KEY (`id`),
+    INDEX (`reference`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '分錄';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `journal_entry`;
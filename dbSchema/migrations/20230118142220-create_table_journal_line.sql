@@ -0,0 +1,24 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`journal_line`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `journal_entry_id` BIGINT UNSIGNED NOT NULL COMMENT '分錄id',
+    `account_id` BIGINT UNSIGNED NOT NULL COMMENT '會計科目id',
+    `transaction_record_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '交易紀錄id',
+    `debit` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '借方金額',
+    `credit` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '貸方金額',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建日期',
+
+    PRIMARY KEY (`id`),
+    INDEX (`account_id`),
+    INDEX (`transaction_record_id`),
+    FOREIGN KEY (`journal_entry_id`) REFERENCES journal_entry(`id`),
+    FOREIGN KEY (`account_id`) REFERENCES ledger_account(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '分錄明細';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `journal_line`;
@@ -0,0 +1,30 @@
+
+-- +migrate Up
+-- open a ledger account for every existing wallet
+INSERT INTO `be-wallet`.`ledger_account` (`code`, `type`, `wallet_id`, `currency`)
+SELECT CONCAT('wallet:', `id`), 1, `id`, `currency` FROM `be-wallet`.`wallet`;
+
+INSERT INTO `be-wallet`.`ledger_account` (`code`, `type`, `currency`)
+SELECT DISTINCT 'opening_balance', 2, `currency` FROM `be-wallet`.`wallet`;
+
+-- one opening entry per currency, crediting each wallet with its current amount
+INSERT INTO `be-wallet`.`journal_entry` (`reference`, `currency`)
+SELECT DISTINCT CONCAT('opening:', `currency`), `currency` FROM `be-wallet`.`wallet`;
+
+INSERT INTO `be-wallet`.`journal_line` (`journal_entry_id`, `account_id`, `debit`, `credit`, `currency`)
+SELECT `je`.`id`, `la`.`id`, 0, `w`.`amount`, `w`.`currency`
+FROM `be-wallet`.`wallet` `w`
+JOIN `be-wallet`.`ledger_account` `la` ON `la`.`wallet_id` = `w`.`id`
+JOIN `be-wallet`.`journal_entry` `je` ON `je`.`reference` = CONCAT('opening:', `w`.`currency`);
+
+INSERT INTO `be-wallet`.`journal_line` (`journal_entry_id`, `account_id`, `debit`, `credit`, `currency`)
+SELECT `je`.`id`, `la`.`id`, SUM(`w`.`amount`), 0, `w`.`currency`
+FROM `be-wallet`.`wallet` `w`
+JOIN `be-wallet`.`ledger_account` `la` ON `la`.`code` = 'opening_balance' AND `la`.`currency` = `w`.`currency`
+JOIN `be-wallet`.`journal_entry` `je` ON `je`.`reference` = CONCAT('opening:', `w`.`currency`)
+GROUP BY `je`.`id`, `la`.`id`, `w`.`currency`;
+
+-- +migrate Down
+DELETE FROM `be-wallet`.`journal_line`;
+DELETE FROM `be-wallet`.`journal_entry`;
+DELETE FROM `be-wallet`.`ledger_account`;
@@ -0,0 +1,14 @@
+
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `idempotency_key` VARCHAR(64) NULL DEFAULT NULL COMMENT '交易冪等鍵' AFTER `transfer_id`,
+    ADD COLUMN `rollback_idempotency_key` VARCHAR(64) NULL DEFAULT NULL COMMENT '回滾冪等鍵' AFTER `idempotency_key`,
+    ADD UNIQUE INDEX (`idempotency_key`),
+    ADD UNIQUE INDEX (`rollback_idempotency_key`);
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP INDEX `idempotency_key`,
+    DROP INDEX `rollback_idempotency_key`,
+    DROP COLUMN `idempotency_key`,
+    DROP COLUMN `rollback_idempotency_key`;
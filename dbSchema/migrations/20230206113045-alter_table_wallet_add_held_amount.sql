@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet`
+    ADD COLUMN `held_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '保留金額' AFTER `amount`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet`
+    DROP COLUMN `held_amount`;
@@ -0,0 +1,25 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`wallet_hold`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `member_id` BIGINT UNSIGNED NOT NULL COMMENT '會員id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `amount` DECIMAL(19,4) NOT NULL COMMENT '保留金額',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `status` TINYINT(4) UNSIGNED NOT NULL COMMENT '保留狀態 1:保留中 2:已扣款 3:已釋放',
+    `committer_id` BIGINT UNSIGNED NOT NULL COMMENT '執行者id',
+    `transaction_record_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '扣款交易紀錄id',
+    `remark` VARCHAR(128) NULL DEFAULT NULL COMMENT '註記',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`wallet_id`, `status`),
+    FOREIGN KEY (`wallet_id`) REFERENCES wallet(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '錢包保留金';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `wallet_hold`;
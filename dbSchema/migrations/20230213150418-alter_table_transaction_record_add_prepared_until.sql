@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `prepared_until` TIMESTAMP NULL DEFAULT NULL COMMENT '預備交易期限' AFTER `rollback_idempotency_key`,
+    ADD INDEX (`status`, `prepared_until`);
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP INDEX `status`,
+    DROP COLUMN `prepared_until`;
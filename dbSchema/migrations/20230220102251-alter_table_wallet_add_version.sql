@@ -0,0 +1,16 @@
+
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet`
+    ADD COLUMN `version` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '異動版本' AFTER `currency`;
+
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `wallet_version` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '交易後錢包版本' AFTER `after_amount`,
+    ADD COLUMN `rollback_wallet_version` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '回滾後錢包版本' AFTER `rollback_after_amount`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet`
+    DROP COLUMN `version`;
+
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP COLUMN `wallet_version`,
+    DROP COLUMN `rollback_wallet_version`;
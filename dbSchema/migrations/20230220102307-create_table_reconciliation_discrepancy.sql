@@ -0,0 +1,25 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`reconciliation_discrepancy`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `run_id` VARCHAR(36) NOT NULL COMMENT '對帳批次id',
+    `member_id` BIGINT UNSIGNED NOT NULL COMMENT '會員id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `transaction_record_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '交易紀錄id',
+    `type` TINYINT(4) UNSIGNED NOT NULL COMMENT '差異類型 1:餘額不符 2:前後金額不連續',
+    `expected_amount` DECIMAL(19,4) NOT NULL COMMENT '預期金額',
+    `actual_amount` DECIMAL(19,4) NOT NULL COMMENT '實際金額',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`run_id`),
+    INDEX (`member_id`, `created_at` DESC),
+    INDEX (`created_at` DESC)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '對帳差異';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `reconciliation_discrepancy`;
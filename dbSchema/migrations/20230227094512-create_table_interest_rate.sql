@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`interest_rate`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `min_balance` DECIMAL(19,4) NOT NULL COMMENT '級距下限',
+    `annual_rate` DECIMAL(9,6) NOT NULL COMMENT '年利率',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`currency`, `min_balance`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '利率級距';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `interest_rate`;
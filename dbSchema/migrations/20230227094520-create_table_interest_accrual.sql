@@ -0,0 +1,26 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`interest_accrual`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `member_id` BIGINT UNSIGNED NOT NULL COMMENT '會員id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `accrual_date` DATE NOT NULL COMMENT '計息日',
+    `balance` DECIMAL(19,4) NOT NULL COMMENT '計息餘額',
+    `amount` DECIMAL(27,12) NOT NULL COMMENT '應計利息',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `status` TINYINT(4) UNSIGNED NOT NULL COMMENT '狀態 1:應計 2:已入帳',
+    `transaction_record_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '入帳交易紀錄id',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`wallet_id`, `accrual_date`),
+    INDEX (`status`, `accrual_date`),
+    FOREIGN KEY (`wallet_id`) REFERENCES wallet(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '應計利息';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `interest_accrual`;
@@ -0,0 +1,23 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`bonus_campaign`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `name` VARCHAR(64) NOT NULL COMMENT '活動名稱',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `budget` DECIMAL(19,4) NOT NULL COMMENT '總預算',
+    `member_cap` DECIMAL(19,4) NOT NULL COMMENT '每位會員上限',
+    `start_at` TIMESTAMP NOT NULL COMMENT '開始時間',
+    `end_at` TIMESTAMP NOT NULL COMMENT '結束時間',
+    `committer_id` BIGINT UNSIGNED NOT NULL COMMENT '建立者id',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`start_at`, `end_at`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '贈送活動';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `bonus_campaign`;
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `campaign_id` BIGINT UNSIGNED NULL DEFAULT NULL COMMENT '贈送活動id' AFTER `transfer_id`,
+    ADD INDEX (`campaign_id`, `member_id`);
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP INDEX `campaign_id`,
+    DROP COLUMN `campaign_id`;
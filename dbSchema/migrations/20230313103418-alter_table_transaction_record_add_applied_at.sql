@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `applied_at` TIMESTAMP NULL DEFAULT NULL COMMENT '入帳時間' AFTER `wallet_version`,
+    ADD COLUMN `rolled_back_at` TIMESTAMP NULL DEFAULT NULL COMMENT '回滾時間' AFTER `rollback_wallet_version`,
+    ADD INDEX `wallet_id_applied_at` (`wallet_id`, `applied_at`),
+    ADD INDEX `wallet_id_rolled_back_at` (`wallet_id`, `rolled_back_at`);
+
+-- records applied before the columns existed were applied when created, and
+-- last updated when rolled back; keep updated_at from moving with the update
+UPDATE `be-wallet`.`transaction_record`
+    SET `applied_at` = `created_at`, `updated_at` = `updated_at`
+    WHERE `status` IN (2, 4);
+
+UPDATE `be-wallet`.`transaction_record`
+    SET `rolled_back_at` = `updated_at`, `updated_at` = `updated_at`
+    WHERE `status` = 4;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP INDEX `wallet_id_applied_at`,
+    DROP INDEX `wallet_id_rolled_back_at`,
+    DROP COLUMN `applied_at`,
+    DROP COLUMN `rolled_back_at`;
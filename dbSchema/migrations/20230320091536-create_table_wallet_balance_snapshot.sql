@@ -0,0 +1,30 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`wallet_balance_snapshot`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `member_id` BIGINT UNSIGNED NOT NULL COMMENT '會員id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `snapshot_date` DATE NOT NULL COMMENT '快照日',
+    `opening_amount` DECIMAL(19,4) NOT NULL COMMENT '日初餘額',
+    `closing_amount` DECIMAL(19,4) NOT NULL COMMENT '日終餘額',
+    `deposit_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日入金',
+    `withdraw_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日出金',
+    `bonus_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日贈送',
+    `interest_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日利息',
+    `open_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日開倉',
+    `close_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日平倉',
+    `manually_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日人工更改',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`wallet_id`, `snapshot_date`),
+    INDEX (`member_id`, `snapshot_date`),
+    INDEX (`snapshot_date`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '錢包每日餘額快照';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `wallet_balance_snapshot`;
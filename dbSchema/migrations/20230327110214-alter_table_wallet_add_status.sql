@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet`
+    ADD COLUMN `status` TINYINT(4) UNSIGNED NOT NULL DEFAULT 1 COMMENT '錢包狀態 1:啟用 2:凍結 3:僅可扣款 4:僅可入帳 5:關閉' AFTER `currency`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet`
+    DROP COLUMN `status`;
@@ -0,0 +1,23 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`wallet_status_log`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `member_id` BIGINT UNSIGNED NOT NULL COMMENT '會員id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `from_status` TINYINT(4) UNSIGNED NOT NULL COMMENT '原狀態',
+    `to_status` TINYINT(4) UNSIGNED NOT NULL COMMENT '新狀態',
+    `operator_id` BIGINT UNSIGNED NOT NULL COMMENT '操作者id',
+    `reason` VARCHAR(255) NOT NULL COMMENT '原因',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`wallet_id`, `created_at` DESC),
+    INDEX (`member_id`, `created_at` DESC),
+    FOREIGN KEY (`wallet_id`) REFERENCES wallet(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '錢包狀態異動紀錄';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `wallet_status_log`;
@@ -0,0 +1,24 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`transaction_limit`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `currency` VARCHAR(36) NOT NULL COMMENT '幣別',
+    `action` TINYINT(4) UNSIGNED NOT NULL DEFAULT 0 COMMENT '交易類型 0:全部',
+    `wallet_id` BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '錢包id 0:全部',
+    `max_amount` DECIMAL(19,4) NULL DEFAULT NULL COMMENT '單筆上限',
+    `daily_amount` DECIMAL(19,4) NULL DEFAULT NULL COMMENT '每日累計上限',
+    `monthly_amount` DECIMAL(19,4) NULL DEFAULT NULL COMMENT '每月累計上限',
+    `daily_count` INT UNSIGNED NULL DEFAULT NULL COMMENT '每日筆數上限',
+    `monthly_count` INT UNSIGNED NULL DEFAULT NULL COMMENT '每月筆數上限',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`currency`, `action`, `wallet_id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '交易限額';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `transaction_limit`;
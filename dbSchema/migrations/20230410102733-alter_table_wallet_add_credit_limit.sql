@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet`
+    ADD COLUMN `credit_limit` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '信用額度' AFTER `held_amount`,
+    ADD COLUMN `negative_since` TIMESTAMP NULL DEFAULT NULL COMMENT '負餘額起始時間' AFTER `credit_limit`,
+    ADD INDEX (`negative_since`);
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet`
+    DROP INDEX `negative_since`,
+    DROP COLUMN `credit_limit`,
+    DROP COLUMN `negative_since`;
@@ -0,0 +1,27 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`currency`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `code` VARCHAR(36) NOT NULL COMMENT '幣別代碼',
+    `name` VARCHAR(64) NOT NULL COMMENT '顯示名稱',
+    `decimals` TINYINT(4) UNSIGNED NOT NULL COMMENT '小數位數',
+    `min_amount` DECIMAL(19,4) NULL DEFAULT NULL COMMENT '單筆最小金額',
+    `max_amount` DECIMAL(19,4) NULL DEFAULT NULL COMMENT '單筆最大金額',
+    `enabled` TINYINT(1) NOT NULL DEFAULT 1 COMMENT '是否啟用',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE INDEX (`code`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '幣別';
+
+-- keep the currencies already in use, at the precision of the wallet amount
+INSERT INTO `be-wallet`.`currency` (`code`, `name`, `decimals`)
+    SELECT DISTINCT `currency`, `currency`, 4
+    FROM `be-wallet`.`wallet`;
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `currency`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD COLUMN `exchange_rate` DECIMAL(27,12) NULL DEFAULT NULL COMMENT '兌換匯率' AFTER `campaign_id`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP COLUMN `exchange_rate`;
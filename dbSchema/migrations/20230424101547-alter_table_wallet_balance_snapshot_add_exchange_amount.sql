@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet_balance_snapshot`
+    ADD COLUMN `exchange_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日兌換' AFTER `close_amount`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet_balance_snapshot`
+    DROP COLUMN `exchange_amount`;
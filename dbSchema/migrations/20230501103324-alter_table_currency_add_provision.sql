@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`currency`
+    ADD COLUMN `provision` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '新會員預設開立' AFTER `enabled`,
+    ADD COLUMN `starting_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '新會員起始金額' AFTER `provision`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`currency`
+    DROP COLUMN `provision`,
+    DROP COLUMN `starting_amount`;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- deleting a wallet must never take its transaction history with it
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP FOREIGN KEY `transaction_record_ibfk_1`;
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD CONSTRAINT `transaction_record_ibfk_1` FOREIGN KEY (`wallet_id`) REFERENCES `wallet`(`id`) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`transaction_record`
+    DROP FOREIGN KEY `transaction_record_ibfk_1`;
+ALTER TABLE `be-wallet`.`transaction_record`
+    ADD CONSTRAINT `transaction_record_ibfk_1` FOREIGN KEY (`wallet_id`) REFERENCES `wallet`(`id`) ON DELETE CASCADE;
@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`outbox`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `wallet_id` BIGINT UNSIGNED NOT NULL COMMENT '錢包id',
+    `event_type` TINYINT(4) UNSIGNED NOT NULL COMMENT '事件類型 1:交易成功 2:回滾 3:交易失敗 4:錢包建立 5:錢包刪除',
+    `payload` TEXT NOT NULL COMMENT '事件內容',
+    `published_at` TIMESTAMP NULL DEFAULT NULL COMMENT '發布時間',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`published_at`, `id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '事件發件匣';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `outbox`;
@@ -0,0 +1,22 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`webhook`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `url` VARCHAR(255) NOT NULL COMMENT '通知網址',
+    `event_type` TINYINT(4) UNSIGNED NOT NULL COMMENT '事件類型 1:交易成功 2:回滾 3:交易失敗 4:錢包建立 5:錢包刪除',
+    `action` TINYINT(4) UNSIGNED NOT NULL DEFAULT 0 COMMENT '交易動作 0:全部',
+    `secret` VARCHAR(64) NOT NULL COMMENT '簽章密鑰',
+    `enabled` TINYINT(1) NOT NULL DEFAULT 1 COMMENT '是否啟用',
+    `committer_id` BIGINT UNSIGNED NOT NULL COMMENT '建立者id',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`enabled`, `event_type`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '事件通知';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `webhook`;
@@ -0,0 +1,26 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`webhook_delivery`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `webhook_id` BIGINT UNSIGNED NOT NULL COMMENT '事件通知id',
+    `outbox_id` BIGINT UNSIGNED NOT NULL COMMENT '事件id',
+    `event_type` TINYINT(4) UNSIGNED NOT NULL COMMENT '事件類型',
+    `payload` TEXT NOT NULL COMMENT '事件內容',
+    `status` TINYINT(4) UNSIGNED NOT NULL COMMENT '狀態 1:待送出 2:已送達 3:失敗',
+    `attempts` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '嘗試次數',
+    `next_attempt_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次嘗試時間',
+    `delivered_at` TIMESTAMP NULL DEFAULT NULL COMMENT '送達時間',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新時間',
+
+    PRIMARY KEY (`id`),
+    UNIQUE (`webhook_id`, `outbox_id`),
+    INDEX (`status`, `next_attempt_at`),
+    FOREIGN KEY (`webhook_id`) REFERENCES webhook(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '事件通知送出佇列';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `webhook_delivery`;
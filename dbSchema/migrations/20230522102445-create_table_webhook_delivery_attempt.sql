@@ -0,0 +1,21 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS `be-wallet`.`webhook_delivery_attempt`
+(
+    `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'id',
+    `delivery_id` BIGINT UNSIGNED NOT NULL COMMENT '送出佇列id',
+    `attempt` INT UNSIGNED NOT NULL COMMENT '第幾次嘗試',
+    `status_code` INT NULL DEFAULT NULL COMMENT 'HTTP狀態碼',
+    `error` VARCHAR(255) NULL DEFAULT NULL COMMENT '錯誤訊息',
+    `duration_ms` INT UNSIGNED NOT NULL COMMENT '耗時(毫秒)',
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '創建時間',
+
+    PRIMARY KEY (`id`),
+    INDEX (`delivery_id`),
+    FOREIGN KEY (`delivery_id`) REFERENCES webhook_delivery(`id`)
+) AUTO_INCREMENT=1 CHARSET=`utf8mb4` COLLATE=`utf8mb4_general_ci` COMMENT '事件通知送出紀錄';
+
+
+-- +migrate Down
+SET FOREIGN_KEY_CHECKS=0;
+DROP TABLE IF EXISTS `webhook_delivery_attempt`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`wallet_balance_snapshot`
+    ADD COLUMN `settlement_amount` DECIMAL(19,4) NOT NULL DEFAULT 0 COMMENT '當日結清' AFTER `exchange_amount`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`wallet_balance_snapshot`
+    DROP COLUMN `settlement_amount`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `be-wallet`.`interest_accrual`
+    ADD COLUMN `remainder` DECIMAL(27,12) NOT NULL DEFAULT 0 COMMENT '入帳後結轉至下月的未入帳利息' AFTER `amount`;
+
+-- +migrate Down
+ALTER TABLE `be-wallet`.`interest_accrual`
+    DROP COLUMN `remainder`;
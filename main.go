@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"runtime/debug"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/paper-trade-chatbot/be-wallet/service"
 	"github.com/paper-trade-chatbot/be-wallet/service/wallet"
 
+	"github.com/gin-gonic/gin"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"google.golang.org/grpc"
@@ -19,6 +21,7 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
+	"github.com/paper-trade-chatbot/be-common/api"
 	"github.com/paper-trade-chatbot/be-common/config"
 	"github.com/paper-trade-chatbot/be-common/global"
 	"github.com/paper-trade-chatbot/be-common/logging"
@@ -58,7 +61,7 @@ func main() {
 	service.Initialize(ctx)
 	defer service.Finalize(ctx)
 
-	initConfig()
+	initConfig(ctx)
 
 	grpcAddress := fmt.Sprintf("%s:%s",
 		config.GetString("GRPC_SERVER_LISTEN_ADDRESS"),
@@ -89,6 +92,8 @@ func main() {
 		config.GetString("SERVER_LISTEN_ADDRESS"),
 		config.GetString("SERVER_LISTEN_PORT"))
 	httpServer := server.CreateHttpServer(ctx, address)
+	// expose the runtime metrics, the wallet cache hit rate among them
+	api.GetRouter().GET("/debug/vars", gin.WrapH(expvar.Handler()))
 
 	// run cron job
 	go cronjob.Cron()
@@ -112,7 +117,12 @@ func main() {
 
 }
 
-func initConfig() {
+func initConfig(ctx context.Context) {
 	global.Initialize()
 
+	lockStrategy, err := wallet.ParseLockStrategy(config.GetString(wallet.LockStrategyEnv))
+	if err != nil {
+		logging.Error(ctx, "[initConfig] %s: %v, wallets are locked optimistically", wallet.LockStrategyEnv, err)
+	}
+	wallet.SetLockStrategy(lockStrategy)
 }
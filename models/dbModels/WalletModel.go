@@ -1,18 +1,46 @@
 package dbModels
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
+type WalletStatus int
+
+const (
+	WalletStatus_NONE       WalletStatus = iota
+	WalletStatus_Active                  // 啟用
+	WalletStatus_Frozen                  // 凍結
+	WalletStatus_DebitOnly               // 僅可扣款
+	WalletStatus_CreditOnly              // 僅可入帳
+	WalletStatus_Closed                  // 關閉
+)
+
 type WalletModel struct {
-	ID        uint64          `gorm:"column:id; primary_key"`
-	MemberID  uint64          `gorm:"column:member_id"`
-	Amount    decimal.Decimal `gorm:"column:amount"`
-	Currency  string          `gorm:"column:currency"`
-	CreatedAt *time.Time      `gorm:"column:created_at"`
-	UpdatedAt *time.Time      `gorm:"column:updated_at"`
-	DeletedAt gorm.DeletedAt  `gorm:"column:deleted_at"`
+	ID            uint64          `gorm:"column:id; primary_key"`
+	MemberID      uint64          `gorm:"column:member_id"`
+	Amount        decimal.Decimal `gorm:"column:amount"`
+	HeldAmount    decimal.Decimal `gorm:"column:held_amount"`
+	CreditLimit   decimal.Decimal `gorm:"column:credit_limit"`
+	NegativeSince sql.NullTime    `gorm:"column:negative_since"`
+	Currency      string          `gorm:"column:currency"`
+	Status        WalletStatus    `gorm:"column:status"`
+	Version       uint64          `gorm:"column:version"`
+	CreatedAt     *time.Time      `gorm:"column:created_at"`
+	UpdatedAt     *time.Time      `gorm:"column:updated_at"`
+	DeletedAt     gorm.DeletedAt  `gorm:"column:deleted_at"`
+}
+
+// AvailableAmount is the amount not reserved by holds.
+func (m *WalletModel) AvailableAmount() decimal.Decimal {
+	return m.Amount.Sub(m.HeldAmount)
+}
+
+// SpendableAmount is the available amount plus the credit limit, the most a
+// debit can take.
+func (m *WalletModel) SpendableAmount() decimal.Decimal {
+	return m.AvailableAmount().Add(m.CreditLimit)
 }
@@ -0,0 +1,25 @@
+package dbModels
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type BonusCampaignModel struct {
+	ID          uint64          `gorm:"column:id; primary_key"`
+	Name        string          `gorm:"column:name"`
+	Currency    string          `gorm:"column:currency"`
+	Budget      decimal.Decimal `gorm:"column:budget"`
+	MemberCap   decimal.Decimal `gorm:"column:member_cap"`
+	StartAt     time.Time       `gorm:"column:start_at"`
+	EndAt       time.Time       `gorm:"column:end_at"`
+	CommitterID uint64          `gorm:"column:committer_id"`
+	CreatedAt   time.Time       `gorm:"column:created_at"`
+	UpdatedAt   time.Time       `gorm:"column:updated_at"`
+}
+
+// Active tells if bonus can be granted at t.
+func (m *BonusCampaignModel) Active(t time.Time) bool {
+	return !t.Before(m.StartAt) && t.Before(m.EndAt)
+}
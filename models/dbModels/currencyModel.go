@@ -0,0 +1,21 @@
+package dbModels
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type CurrencyModel struct {
+	ID             uint64              `gorm:"column:id; primary_key"`
+	Code           string              `gorm:"column:code"`
+	Name           string              `gorm:"column:name"`
+	Decimals       int32               `gorm:"column:decimals"`
+	MinAmount      decimal.NullDecimal `gorm:"column:min_amount"`
+	MaxAmount      decimal.NullDecimal `gorm:"column:max_amount"`
+	Enabled        bool                `gorm:"column:enabled"`
+	Provision      bool                `gorm:"column:provision"`
+	StartingAmount decimal.Decimal     `gorm:"column:starting_amount"`
+	CreatedAt      time.Time           `gorm:"column:created_at"`
+	UpdatedAt      time.Time           `gorm:"column:updated_at"`
+}
@@ -0,0 +1,31 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type InterestAccrualStatus int
+
+const (
+	InterestAccrualStatus_NONE        InterestAccrualStatus = iota
+	InterestAccrualStatus_Accrued                           // 應計
+	InterestAccrualStatus_Capitalized                       // 已入帳
+)
+
+type InterestAccrualModel struct {
+	ID                  uint64                `gorm:"column:id; primary_key"`
+	MemberID            uint64                `gorm:"column:member_id"`
+	WalletID            uint64                `gorm:"column:wallet_id"`
+	AccrualDate         time.Time             `gorm:"column:accrual_date"`
+	Balance             decimal.Decimal       `gorm:"column:balance"`
+	Amount              decimal.Decimal       `gorm:"column:amount"`
+	Remainder           decimal.Decimal       `gorm:"column:remainder"`
+	Currency            string                `gorm:"column:currency"`
+	Status              InterestAccrualStatus `gorm:"column:status"`
+	TransactionRecordID sql.NullInt64         `gorm:"column:transaction_record_id"`
+	CreatedAt           time.Time             `gorm:"column:created_at"`
+	UpdatedAt           time.Time             `gorm:"column:updated_at"`
+}
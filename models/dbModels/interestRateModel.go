@@ -0,0 +1,18 @@
+package dbModels
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// InterestRateModel is a balance tier of a currency. The part of a balance
+// from MinBalance up to the MinBalance of the next tier earns AnnualRate.
+type InterestRateModel struct {
+	ID         uint64          `gorm:"column:id; primary_key"`
+	Currency   string          `gorm:"column:currency"`
+	MinBalance decimal.Decimal `gorm:"column:min_balance"`
+	AnnualRate decimal.Decimal `gorm:"column:annual_rate"`
+	CreatedAt  time.Time       `gorm:"column:created_at"`
+	UpdatedAt  time.Time       `gorm:"column:updated_at"`
+}
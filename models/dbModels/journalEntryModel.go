@@ -0,0 +1,12 @@
+package dbModels
+
+import (
+	"time"
+)
+
+type JournalEntryModel struct {
+	ID        uint64    `gorm:"column:id; primary_key"`
+	Reference string    `gorm:"column:reference"`
+	Currency  string    `gorm:"column:currency"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
@@ -0,0 +1,19 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type JournalLineModel struct {
+	ID                  uint64          `gorm:"column:id; primary_key"`
+	JournalEntryID      uint64          `gorm:"column:journal_entry_id"`
+	AccountID           uint64          `gorm:"column:account_id"`
+	TransactionRecordID sql.NullInt64   `gorm:"column:transaction_record_id"`
+	Debit               decimal.Decimal `gorm:"column:debit"`
+	Credit              decimal.Decimal `gorm:"column:credit"`
+	Currency            string          `gorm:"column:currency"`
+	CreatedAt           time.Time       `gorm:"column:created_at"`
+}
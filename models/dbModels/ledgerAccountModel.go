@@ -0,0 +1,24 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+)
+
+type LedgerAccountType int
+
+const (
+	LedgerAccountType_NONE   LedgerAccountType = iota
+	LedgerAccountType_Wallet                   // 會員錢包
+	LedgerAccountType_System                   // 系統科目
+)
+
+type LedgerAccountModel struct {
+	ID        uint64            `gorm:"column:id; primary_key"`
+	Code      string            `gorm:"column:code"`
+	Type      LedgerAccountType `gorm:"column:type"`
+	WalletID  sql.NullInt64     `gorm:"column:wallet_id"`
+	Currency  string            `gorm:"column:currency"`
+	CreatedAt time.Time         `gorm:"column:created_at"`
+	UpdatedAt time.Time         `gorm:"column:updated_at"`
+}
@@ -0,0 +1,33 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+)
+
+type WalletEventType int
+
+const (
+	WalletEventType_NONE              WalletEventType = iota
+	WalletEventType_Transaction                       // 交易成功
+	WalletEventType_Rollback                          // 回滾
+	WalletEventType_TransactionFailed                 // 交易失敗
+	WalletEventType_WalletCreated                     // 錢包建立
+	WalletEventType_WalletDeleted                     // 錢包刪除
+)
+
+// BalanceChanged tells if the event changed the wallet amount.
+func (t WalletEventType) BalanceChanged() bool {
+	return t == WalletEventType_Transaction || t == WalletEventType_Rollback
+}
+
+// OutboxModel is an event written in the transaction that caused it, waiting
+// to be published.
+type OutboxModel struct {
+	ID          uint64          `gorm:"column:id; primary_key"`
+	WalletID    uint64          `gorm:"column:wallet_id"`
+	EventType   WalletEventType `gorm:"column:event_type"`
+	Payload     string          `gorm:"column:payload"`
+	PublishedAt sql.NullTime    `gorm:"column:published_at"`
+	CreatedAt   time.Time       `gorm:"column:created_at"`
+}
@@ -0,0 +1,29 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ReconciliationDiscrepancyType int
+
+const (
+	ReconciliationDiscrepancyType_NONE            ReconciliationDiscrepancyType = iota
+	ReconciliationDiscrepancyType_BalanceMismatch                               // 餘額不符
+	ReconciliationDiscrepancyType_ChainBroken                                   // 前後金額不連續
+)
+
+type ReconciliationDiscrepancyModel struct {
+	ID                  uint64                        `gorm:"column:id; primary_key"`
+	RunID               string                        `gorm:"column:run_id"`
+	MemberID            uint64                        `gorm:"column:member_id"`
+	WalletID            uint64                        `gorm:"column:wallet_id"`
+	TransactionRecordID sql.NullInt64                 `gorm:"column:transaction_record_id"`
+	Type                ReconciliationDiscrepancyType `gorm:"column:type"`
+	ExpectedAmount      decimal.Decimal               `gorm:"column:expected_amount"`
+	ActualAmount        decimal.Decimal               `gorm:"column:actual_amount"`
+	Currency            string                        `gorm:"column:currency"`
+	CreatedAt           time.Time                     `gorm:"column:created_at"`
+}
@@ -0,0 +1,25 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TransactionLimitModel limits the transactions of a currency. Action and
+// WalletID of zero apply to every action and every wallet, a row of a wallet
+// overrides the one of every wallet. A null limit is unlimited.
+type TransactionLimitModel struct {
+	ID            uint64              `gorm:"column:id; primary_key"`
+	Currency      string              `gorm:"column:currency"`
+	Action        TransactionAction   `gorm:"column:action"`
+	WalletID      uint64              `gorm:"column:wallet_id"`
+	MaxAmount     decimal.NullDecimal `gorm:"column:max_amount"`
+	DailyAmount   decimal.NullDecimal `gorm:"column:daily_amount"`
+	MonthlyAmount decimal.NullDecimal `gorm:"column:monthly_amount"`
+	DailyCount    sql.NullInt64       `gorm:"column:daily_count"`
+	MonthlyCount  sql.NullInt64       `gorm:"column:monthly_count"`
+	CreatedAt     time.Time           `gorm:"column:created_at"`
+	UpdatedAt     time.Time           `gorm:"column:updated_at"`
+}
@@ -10,14 +10,16 @@ import (
 type TransactionAction int
 
 const (
-	TransactionAction_NONE     TransactionAction = iota
-	TransactionAction_Deposit                    // 入金
-	TransactionAction_Withdraw                   // 出金
-	TransactionAction_Bonus                      // 贈送
-	TransactionAction_Interest                   // 利息
-	TransactionAction_Open                       // 開倉
-	TransactionAction_Close                      // 平倉
-	TransactionAction_Manually                   // 人工更改
+	TransactionAction_NONE       TransactionAction = iota
+	TransactionAction_Deposit                      // 入金
+	TransactionAction_Withdraw                     // 出金
+	TransactionAction_Bonus                        // 贈送
+	TransactionAction_Interest                     // 利息
+	TransactionAction_Open                         // 開倉
+	TransactionAction_Close                        // 平倉
+	TransactionAction_Manually                     // 人工更改
+	TransactionAction_Exchange                     // 兌換
+	TransactionAction_Settlement                   // 結清
 )
 
 type TransactionStatus int
@@ -31,20 +33,30 @@ const (
 )
 
 type TransactionRecordModel struct {
-	ID                   uint64              `gorm:"column:id; primary_key"`
-	MemberID             uint64              `gorm:"column:member_id"`
-	WalletID             uint64              `gorm:"column:wallet_id"`
-	Action               TransactionAction   `gorm:"column:action"`
-	Amount               decimal.Decimal     `gorm:"column:amount"`
-	BeforeAmount         decimal.NullDecimal `gorm:"column:before_amount"`
-	AfterAmount          decimal.NullDecimal `gorm:"column:after_amount"`
-	Currency             string              `gorm:"column:currency"`
-	CommitterID          uint64              `gorm:"column:committer_id"`
-	Status               TransactionStatus   `gorm:"column:status"`
-	Remark               sql.NullString      `gorm:"column:remark"`
-	CreatedAt            time.Time           `gorm:"column:created_at"`
-	UpdatedAt            time.Time           `gorm:"column:updated_at"`
-	RollbackBeforeAmount decimal.NullDecimal `gorm:"column:rollback_before_amount"`
-	RollbackAfterAmount  decimal.NullDecimal `gorm:"column:rollback_after_amount"`
-	RollbackerID         sql.NullInt64       `gorm:"column:rollbacker_id"`
+	ID                     uint64              `gorm:"column:id; primary_key"`
+	MemberID               uint64              `gorm:"column:member_id"`
+	WalletID               uint64              `gorm:"column:wallet_id"`
+	Action                 TransactionAction   `gorm:"column:action"`
+	Amount                 decimal.Decimal     `gorm:"column:amount"`
+	BeforeAmount           decimal.NullDecimal `gorm:"column:before_amount"`
+	AfterAmount            decimal.NullDecimal `gorm:"column:after_amount"`
+	WalletVersion          sql.NullInt64       `gorm:"column:wallet_version"`
+	AppliedAt              sql.NullTime        `gorm:"column:applied_at"`
+	Currency               string              `gorm:"column:currency"`
+	CommitterID            uint64              `gorm:"column:committer_id"`
+	Status                 TransactionStatus   `gorm:"column:status"`
+	Remark                 sql.NullString      `gorm:"column:remark"`
+	CreatedAt              time.Time           `gorm:"column:created_at"`
+	UpdatedAt              time.Time           `gorm:"column:updated_at"`
+	RollbackBeforeAmount   decimal.NullDecimal `gorm:"column:rollback_before_amount"`
+	RollbackAfterAmount    decimal.NullDecimal `gorm:"column:rollback_after_amount"`
+	RollbackWalletVersion  sql.NullInt64       `gorm:"column:rollback_wallet_version"`
+	RolledBackAt           sql.NullTime        `gorm:"column:rolled_back_at"`
+	RollbackerID           sql.NullInt64       `gorm:"column:rollbacker_id"`
+	TransferID             sql.NullString      `gorm:"column:transfer_id"`
+	CampaignID             sql.NullInt64       `gorm:"column:campaign_id"`
+	ExchangeRate           decimal.NullDecimal `gorm:"column:exchange_rate"`
+	IdempotencyKey         sql.NullString      `gorm:"column:idempotency_key"`
+	RollbackIdempotencyKey sql.NullString      `gorm:"column:rollback_idempotency_key"`
+	PreparedUntil          sql.NullTime        `gorm:"column:prepared_until"`
 }
@@ -0,0 +1,58 @@
+package dbModels
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// WalletBalanceSnapshotModel is a wallet at the end of a UTC day. The amounts
+// of each action are what the day added, net of what the day rolled back.
+type WalletBalanceSnapshotModel struct {
+	ID               uint64          `gorm:"column:id; primary_key"`
+	MemberID         uint64          `gorm:"column:member_id"`
+	WalletID         uint64          `gorm:"column:wallet_id"`
+	Currency         string          `gorm:"column:currency"`
+	SnapshotDate     time.Time       `gorm:"column:snapshot_date"`
+	OpeningAmount    decimal.Decimal `gorm:"column:opening_amount"`
+	ClosingAmount    decimal.Decimal `gorm:"column:closing_amount"`
+	DepositAmount    decimal.Decimal `gorm:"column:deposit_amount"`
+	WithdrawAmount   decimal.Decimal `gorm:"column:withdraw_amount"`
+	BonusAmount      decimal.Decimal `gorm:"column:bonus_amount"`
+	InterestAmount   decimal.Decimal `gorm:"column:interest_amount"`
+	OpenAmount       decimal.Decimal `gorm:"column:open_amount"`
+	CloseAmount      decimal.Decimal `gorm:"column:close_amount"`
+	ExchangeAmount   decimal.Decimal `gorm:"column:exchange_amount"`
+	SettlementAmount decimal.Decimal `gorm:"column:settlement_amount"`
+	ManuallyAmount   decimal.Decimal `gorm:"column:manually_amount"`
+	CreatedAt        time.Time       `gorm:"column:created_at"`
+}
+
+// PnLAmount is what trading made on the day.
+func (m *WalletBalanceSnapshotModel) PnLAmount() decimal.Decimal {
+	return m.OpenAmount.Add(m.CloseAmount)
+}
+
+// AddActionAmount adds amount to the subtotal of action.
+func (m *WalletBalanceSnapshotModel) AddActionAmount(action TransactionAction, amount decimal.Decimal) {
+	switch action {
+	case TransactionAction_Deposit:
+		m.DepositAmount = m.DepositAmount.Add(amount)
+	case TransactionAction_Withdraw:
+		m.WithdrawAmount = m.WithdrawAmount.Add(amount)
+	case TransactionAction_Bonus:
+		m.BonusAmount = m.BonusAmount.Add(amount)
+	case TransactionAction_Interest:
+		m.InterestAmount = m.InterestAmount.Add(amount)
+	case TransactionAction_Open:
+		m.OpenAmount = m.OpenAmount.Add(amount)
+	case TransactionAction_Close:
+		m.CloseAmount = m.CloseAmount.Add(amount)
+	case TransactionAction_Exchange:
+		m.ExchangeAmount = m.ExchangeAmount.Add(amount)
+	case TransactionAction_Settlement:
+		m.SettlementAmount = m.SettlementAmount.Add(amount)
+	default:
+		m.ManuallyAmount = m.ManuallyAmount.Add(amount)
+	}
+}
@@ -0,0 +1,31 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type WalletHoldStatus int
+
+const (
+	WalletHoldStatus_NONE     WalletHoldStatus = iota
+	WalletHoldStatus_Held                      // 保留中
+	WalletHoldStatus_Captured                  // 已扣款
+	WalletHoldStatus_Released                  // 已釋放
+)
+
+type WalletHoldModel struct {
+	ID                  uint64           `gorm:"column:id; primary_key"`
+	MemberID            uint64           `gorm:"column:member_id"`
+	WalletID            uint64           `gorm:"column:wallet_id"`
+	Amount              decimal.Decimal  `gorm:"column:amount"`
+	Currency            string           `gorm:"column:currency"`
+	Status              WalletHoldStatus `gorm:"column:status"`
+	CommitterID         uint64           `gorm:"column:committer_id"`
+	TransactionRecordID sql.NullInt64    `gorm:"column:transaction_record_id"`
+	Remark              sql.NullString   `gorm:"column:remark"`
+	CreatedAt           time.Time        `gorm:"column:created_at"`
+	UpdatedAt           time.Time        `gorm:"column:updated_at"`
+}
@@ -0,0 +1,16 @@
+package dbModels
+
+import (
+	"time"
+)
+
+type WalletStatusLogModel struct {
+	ID         uint64       `gorm:"column:id; primary_key"`
+	MemberID   uint64       `gorm:"column:member_id"`
+	WalletID   uint64       `gorm:"column:wallet_id"`
+	FromStatus WalletStatus `gorm:"column:from_status"`
+	ToStatus   WalletStatus `gorm:"column:to_status"`
+	OperatorID uint64       `gorm:"column:operator_id"`
+	Reason     string       `gorm:"column:reason"`
+	CreatedAt  time.Time    `gorm:"column:created_at"`
+}
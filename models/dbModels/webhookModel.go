@@ -0,0 +1,53 @@
+package dbModels
+
+import (
+	"database/sql"
+	"time"
+)
+
+type WebhookDeliveryStatus int
+
+const (
+	WebhookDeliveryStatus_NONE      WebhookDeliveryStatus = iota
+	WebhookDeliveryStatus_Pending                         // 待送出
+	WebhookDeliveryStatus_Delivered                       // 已送達
+	WebhookDeliveryStatus_Failed                          // 失敗
+)
+
+// WebhookModel is a URL called on the events of EventType. Action narrows the
+// events down to the transactions of one action, zero matches any.
+type WebhookModel struct {
+	ID          uint64            `gorm:"column:id; primary_key"`
+	URL         string            `gorm:"column:url"`
+	EventType   WalletEventType   `gorm:"column:event_type"`
+	Action      TransactionAction `gorm:"column:action"`
+	Secret      string            `gorm:"column:secret"`
+	Enabled     bool              `gorm:"column:enabled"`
+	CommitterID uint64            `gorm:"column:committer_id"`
+	CreatedAt   time.Time         `gorm:"column:created_at"`
+	UpdatedAt   time.Time         `gorm:"column:updated_at"`
+}
+
+type WebhookDeliveryModel struct {
+	ID            uint64                `gorm:"column:id; primary_key"`
+	WebhookID     uint64                `gorm:"column:webhook_id"`
+	OutboxID      uint64                `gorm:"column:outbox_id"`
+	EventType     WalletEventType       `gorm:"column:event_type"`
+	Payload       string                `gorm:"column:payload"`
+	Status        WebhookDeliveryStatus `gorm:"column:status"`
+	Attempts      int                   `gorm:"column:attempts"`
+	NextAttemptAt time.Time             `gorm:"column:next_attempt_at"`
+	DeliveredAt   sql.NullTime          `gorm:"column:delivered_at"`
+	CreatedAt     time.Time             `gorm:"column:created_at"`
+	UpdatedAt     time.Time             `gorm:"column:updated_at"`
+}
+
+type WebhookDeliveryAttemptModel struct {
+	ID         uint64         `gorm:"column:id; primary_key"`
+	DeliveryID uint64         `gorm:"column:delivery_id"`
+	Attempt    int            `gorm:"column:attempt"`
+	StatusCode sql.NullInt32  `gorm:"column:status_code"`
+	Error      sql.NullString `gorm:"column:error"`
+	DurationMs int64          `gorm:"column:duration_ms"`
+	CreatedAt  time.Time      `gorm:"column:created_at"`
+}
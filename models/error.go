@@ -0,0 +1,75 @@
+package models
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrCode uint32
+
+// wallet errors not yet defined in be-common, numbered after its 80xx range
+const (
+	ErrCode_NoSuchHold             ErrCode = 8101
+	ErrCode_HoldNotActive          ErrCode = 8102
+	ErrCode_TransactionNotPrepared ErrCode = 8103
+	ErrCode_NoSuchCampaign         ErrCode = 8104
+	ErrCode_CampaignNotActive      ErrCode = 8105
+	ErrCode_CampaignBudgetExceeded ErrCode = 8106
+	ErrCode_CampaignCapExceeded    ErrCode = 8107
+	ErrCode_WalletFrozen           ErrCode = 8108
+	ErrCode_WalletDebitOnly        ErrCode = 8109
+	ErrCode_WalletCreditOnly       ErrCode = 8110
+	ErrCode_WalletClosed           ErrCode = 8111
+	ErrCode_MaxAmountExceeded      ErrCode = 8112
+	ErrCode_DailyAmountExceeded    ErrCode = 8113
+	ErrCode_MonthlyAmountExceeded  ErrCode = 8114
+	ErrCode_DailyCountExceeded     ErrCode = 8115
+	ErrCode_MonthlyCountExceeded   ErrCode = 8116
+	ErrCode_NoSuchCurrency         ErrCode = 8117
+	ErrCode_CurrencyDisabled       ErrCode = 8118
+	ErrCode_CurrencyMismatch       ErrCode = 8119
+	ErrCode_AmountOutOfRange       ErrCode = 8120
+	ErrCode_NoSuchExchangeRate     ErrCode = 8121
+	ErrCode_WalletNotSettled       ErrCode = 8122
+	ErrCode_NoSuchWebhook          ErrCode = 8123
+	ErrCode_NoSuchLedgerAccount    ErrCode = 8124
+	ErrCode_SubscriberTooSlow      ErrCode = 8125
+	ErrCode_TransactionExpired     ErrCode = 8126
+	ErrCode_IdempotencyKeyConflict ErrCode = 8127
+	ErrCode_MemberNotDeleted       ErrCode = 8128
+	ErrCode_WalletStatusChange     ErrCode = 8129
+	ErrCode_WebhookNotPublic       ErrCode = 8130
+)
+
+var (
+	ErrNoSuchHold             = status.Error(codes.Code(ErrCode_NoSuchHold), "no such hold")
+	ErrHoldNotActive          = status.Error(codes.Code(ErrCode_HoldNotActive), "hold is not active")
+	ErrTransactionNotPrepared = status.Error(codes.Code(ErrCode_TransactionNotPrepared), "this transaction is not prepared")
+	ErrNoSuchCampaign         = status.Error(codes.Code(ErrCode_NoSuchCampaign), "no such campaign")
+	ErrCampaignNotActive      = status.Error(codes.Code(ErrCode_CampaignNotActive), "campaign is not active")
+	ErrCampaignBudgetExceeded = status.Error(codes.Code(ErrCode_CampaignBudgetExceeded), "campaign budget exceeded")
+	ErrCampaignCapExceeded    = status.Error(codes.Code(ErrCode_CampaignCapExceeded), "campaign member cap exceeded")
+	ErrWalletFrozen           = status.Error(codes.Code(ErrCode_WalletFrozen), "wallet is frozen")
+	ErrWalletDebitOnly        = status.Error(codes.Code(ErrCode_WalletDebitOnly), "wallet only allows debits")
+	ErrWalletCreditOnly       = status.Error(codes.Code(ErrCode_WalletCreditOnly), "wallet only allows credits")
+	ErrWalletClosed           = status.Error(codes.Code(ErrCode_WalletClosed), "wallet is closed")
+	ErrMaxAmountExceeded      = status.Error(codes.Code(ErrCode_MaxAmountExceeded), "transaction amount over the single transaction limit")
+	ErrDailyAmountExceeded    = status.Error(codes.Code(ErrCode_DailyAmountExceeded), "transaction amount over the daily limit")
+	ErrMonthlyAmountExceeded  = status.Error(codes.Code(ErrCode_MonthlyAmountExceeded), "transaction amount over the monthly limit")
+	ErrDailyCountExceeded     = status.Error(codes.Code(ErrCode_DailyCountExceeded), "too many transactions today")
+	ErrMonthlyCountExceeded   = status.Error(codes.Code(ErrCode_MonthlyCountExceeded), "too many transactions this month")
+	ErrNoSuchCurrency         = status.Error(codes.Code(ErrCode_NoSuchCurrency), "no such currency")
+	ErrCurrencyDisabled       = status.Error(codes.Code(ErrCode_CurrencyDisabled), "currency is disabled")
+	ErrCurrencyMismatch       = status.Error(codes.Code(ErrCode_CurrencyMismatch), "currency does not match the wallet")
+	ErrAmountOutOfRange       = status.Error(codes.Code(ErrCode_AmountOutOfRange), "amount out of the range of the currency")
+	ErrNoSuchExchangeRate     = status.Error(codes.Code(ErrCode_NoSuchExchangeRate), "no exchange rate between the currencies")
+	ErrWalletNotSettled       = status.Error(codes.Code(ErrCode_WalletNotSettled), "wallet still has a balance or holds")
+	ErrNoSuchWebhook          = status.Error(codes.Code(ErrCode_NoSuchWebhook), "no such webhook")
+	ErrNoSuchLedgerAccount    = status.Error(codes.Code(ErrCode_NoSuchLedgerAccount), "no such ledger account")
+	ErrSubscriberTooSlow      = status.Error(codes.Code(ErrCode_SubscriberTooSlow), "subscriber fell too far behind the wallet events")
+	ErrTransactionExpired     = status.Error(codes.Code(ErrCode_TransactionExpired), "prepared transaction has expired")
+	ErrIdempotencyKeyConflict = status.Error(codes.Code(ErrCode_IdempotencyKeyConflict), "idempotency key already used by another request")
+	ErrMemberNotDeleted       = status.Error(codes.Code(ErrCode_MemberNotDeleted), "member is not deleted")
+	ErrWalletStatusChange     = status.Error(codes.Code(ErrCode_WalletStatusChange), "wallet can not change to this status")
+	ErrWebhookNotPublic       = status.Error(codes.Code(ErrCode_WebhookNotPublic), "webhook url is not a public address")
+)
@@ -0,0 +1,57 @@
+package currency
+
+import (
+	"github.com/paper-trade-chatbot/be-wallet/dao/currencyDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// MaxDecimals is the precision of amounts in the database.
+const MaxDecimals = 4
+
+// maxMagnitude bounds amounts from above when the currency sets no maximum,
+// the amounts of the database being DECIMAL(19,4).
+var maxMagnitude = decimal.New(1, 19-MaxDecimals)
+
+// Enabled returns the currency of code if it can be used.
+func Enabled(tx *gorm.DB, code string) (*dbModels.CurrencyModel, error) {
+	currency, err := currencyDao.Get(tx, &currencyDao.QueryModel{
+		Code: []string{code},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if currency == nil {
+		return nil, models.ErrNoSuchCurrency
+	}
+	if !currency.Enabled {
+		return nil, models.ErrCurrencyDisabled
+	}
+	return currency, nil
+}
+
+// Fits tells if amount can be stored in the currency, taking at most its
+// decimal places and fitting the DECIMAL(19,4) amounts of the database.
+func Fits(decimals int32, amount decimal.Decimal) bool {
+	return amount.Equal(amount.Round(decimals)) && amount.Abs().LessThan(maxMagnitude)
+}
+
+// Amount rounds amount to the decimal places of the currency and checks that
+// its magnitude is within the range of a single transaction, or fits the
+// database if the currency has no maximum.
+func Amount(currency *dbModels.CurrencyModel, amount decimal.Decimal) (decimal.Decimal, error) {
+	amount = amount.Round(currency.Decimals)
+	magnitude := amount.Abs()
+	if currency.MinAmount.Valid && magnitude.LessThan(currency.MinAmount.Decimal) {
+		return amount, models.ErrAmountOutOfRange
+	}
+	if currency.MaxAmount.Valid && magnitude.GreaterThan(currency.MaxAmount.Decimal) {
+		return amount, models.ErrAmountOutOfRange
+	}
+	if !currency.MaxAmount.Valid && !magnitude.LessThan(maxMagnitude) {
+		return amount, models.ErrAmountOutOfRange
+	}
+	return amount, nil
+}
@@ -0,0 +1,84 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+)
+
+func TestAmount(t *testing.T) {
+	limited := &dbModels.CurrencyModel{
+		Code:      "USD",
+		Decimals:  2,
+		MinAmount: decimal.NewNullDecimal(decimal.RequireFromString("0.1")),
+		MaxAmount: decimal.NewNullDecimal(decimal.RequireFromString("1000")),
+	}
+	unlimited := &dbModels.CurrencyModel{
+		Code:     "BTC",
+		Decimals: 4,
+	}
+
+	tests := []struct {
+		name     string
+		currency *dbModels.CurrencyModel
+		amount   string
+		want     string
+		err      error
+	}{
+		{"within range", limited, "12.34", "12.34", nil},
+		{"rounded half up", limited, "12.345", "12.35", nil},
+		{"rounded down", limited, "12.344", "12.34", nil},
+		{"negative rounded half away from zero", limited, "-12.345", "-12.35", nil},
+		{"negative within range", limited, "-500", "-500", nil},
+		{"minimum", limited, "0.1", "0.1", nil},
+		{"under minimum after rounding", limited, "0.094", "0.09", models.ErrAmountOutOfRange},
+		{"at minimum after rounding", limited, "0.095", "0.1", nil},
+		{"negative under minimum", limited, "-0.05", "-0.05", models.ErrAmountOutOfRange},
+		{"maximum", limited, "1000", "1000", nil},
+		{"over maximum after rounding", limited, "1000.005", "1000.01", models.ErrAmountOutOfRange},
+		{"negative over maximum", limited, "-1000.01", "-1000.01", models.ErrAmountOutOfRange},
+		{"zero without minimum", unlimited, "0", "0", nil},
+		{"under the database limit", unlimited, "999999999999999.9999", "999999999999999.9999", nil},
+		{"rounded up to the database limit", unlimited, "999999999999999.99995", "1000000000000000", models.ErrAmountOutOfRange},
+		{"negative at the database limit", unlimited, "-1000000000000000", "-1000000000000000", models.ErrAmountOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Amount(tt.currency, decimal.RequireFromString(tt.amount))
+			if err != tt.err {
+				t.Errorf("Amount(%s) error = %v, want %v", tt.amount, err, tt.err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Amount(%s) = %s, want %s", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFits(t *testing.T) {
+	tests := []struct {
+		decimals int32
+		amount   string
+		want     bool
+	}{
+		{2, "0", true},
+		{2, "12.34", true},
+		{2, "12.345", false},
+		{0, "12", true},
+		{0, "12.5", false},
+		{4, "999999999999999.9999", true},
+		{4, "-999999999999999.9999", true},
+		{4, "1000000000000000", false},
+		{4, "-1000000000000000", false},
+		{4, "0.00001", false},
+	}
+
+	for _, tt := range tests {
+		if got := Fits(tt.decimals, decimal.RequireFromString(tt.amount)); got != tt.want {
+			t.Errorf("Fits(%d, %s) = %t, want %t", tt.decimals, tt.amount, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package interest
+
+import (
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+)
+
+const daysPerYear = 365
+
+// the precision of accrued interest in the database
+const decimals = 12
+
+// Daily is one day of interest on balance, each part of the balance earning the
+// annual rate of its tier. tiers are ordered by MinBalance, and a balance at or
+// below the first one earns nothing.
+func Daily(tiers []dbModels.InterestRateModel, balance decimal.Decimal) decimal.Decimal {
+	yearly := decimal.Zero
+	for i, t := range tiers {
+		if balance.LessThanOrEqual(t.MinBalance) {
+			break
+		}
+		upper := balance
+		if i+1 < len(tiers) && tiers[i+1].MinBalance.LessThan(balance) {
+			upper = tiers[i+1].MinBalance
+		}
+		yearly = yearly.Add(upper.Sub(t.MinBalance).Mul(t.AnnualRate))
+	}
+	return yearly.Div(decimal.NewFromInt(daysPerYear)).Truncate(decimals)
+}
@@ -0,0 +1,59 @@
+package interest
+
+import (
+	"testing"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+)
+
+func TestDaily(t *testing.T) {
+	tier := func(minBalance, annualRate string) dbModels.InterestRateModel {
+		return dbModels.InterestRateModel{
+			Currency:   "USD",
+			MinBalance: decimal.RequireFromString(minBalance),
+			AnnualRate: decimal.RequireFromString(annualRate),
+		}
+	}
+	flat := []dbModels.InterestRateModel{
+		tier("0", "0.0365"),
+	}
+	tiered := []dbModels.InterestRateModel{
+		tier("0", "0.01"),
+		tier("1000", "0.0365"),
+		tier("10000", "0.073"),
+	}
+
+	tests := []struct {
+		name    string
+		tiers   []dbModels.InterestRateModel
+		balance string
+		want    string
+	}{
+		{"no tiers", nil, "1000", "0"},
+		{"zero balance", flat, "0", "0"},
+		{"negative balance", flat, "-500", "0"},
+		{"flat rate", flat, "1000", "0.1"},
+		{"at the minimum of the first tier", []dbModels.InterestRateModel{tier("100", "0.0365")}, "100", "0"},
+		{"below the minimum of the first tier", []dbModels.InterestRateModel{tier("100", "0.0365")}, "50", "0"},
+		{"above the minimum of the first tier", []dbModels.InterestRateModel{tier("100", "0.0365")}, "1100", "0.1"},
+		{"within the first tier", tiered, "730", "0.02"},
+		{"at the boundary of the second tier", tiered, "1000", "0.027397260273"},
+		// 1000 at 1% and 1000 at 3.65%
+		{"spanning two tiers", tiered, "2000", "0.127397260273"},
+		// 1000 at 1%, 9000 at 3.65% and 10000 at 7.3%
+		{"spanning three tiers", tiered, "20000", "2.927397260273"},
+		// 0.005479452054794...
+		{"truncated, not rounded", []dbModels.InterestRateModel{tier("0", "0.01")}, "200", "0.005479452054"},
+		{"smallest amount", []dbModels.InterestRateModel{tier("0", "0.01")}, "0.0001", "0.000000002739"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Daily(tt.tiers, decimal.RequireFromString(tt.balance))
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Daily(%s) = %s, want %s", tt.balance, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/journalEntryDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/journalLineDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/ledgerAccountDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// system account codes, every wallet movement is balanced against one of them
+const (
+	Account_OpeningBalance   = "opening_balance"   // 期初餘額
+	Account_Funding          = "funding"           // 出入金
+	Account_BonusPool        = "bonus_pool"        // 贈送
+	Account_InterestExpense  = "interest_expense"  // 利息支出
+	Account_TradingPnL       = "trading_pnl"       // 交易損益
+	Account_Exchange         = "exchange"          // 兌換
+	Account_Settlement       = "settlement"        // 會員結清
+	Account_ManualAdjustment = "manual_adjustment" // 人工調整
+)
+
+// CounterAccount returns the system account that the action moves money from.
+func CounterAccount(action dbModels.TransactionAction) string {
+	switch action {
+	case dbModels.TransactionAction_Deposit, dbModels.TransactionAction_Withdraw:
+		return Account_Funding
+	case dbModels.TransactionAction_Bonus:
+		return Account_BonusPool
+	case dbModels.TransactionAction_Interest:
+		return Account_InterestExpense
+	case dbModels.TransactionAction_Open, dbModels.TransactionAction_Close:
+		return Account_TradingPnL
+	case dbModels.TransactionAction_Exchange:
+		return Account_Exchange
+	case dbModels.TransactionAction_Settlement:
+		return Account_Settlement
+	default:
+		return Account_ManualAdjustment
+	}
+}
+
+// TransactionReference is the journal entry reference of a transaction.
+func TransactionReference(record *dbModels.TransactionRecordModel) string {
+	if record.TransferID.Valid {
+		return "transfer:" + record.TransferID.String
+	}
+	return fmt.Sprintf("transaction:%d", record.ID)
+}
+
+// RollbackReference is the journal entry reference of a rollback.
+func RollbackReference(record *dbModels.TransactionRecordModel) string {
+	return "rollback:" + TransactionReference(record)
+}
+
+// Post journals the records as one balanced entry per currency. Each record
+// credits its amount to its wallet account, and whatever the records of a
+// currency do not balance among themselves, as the two legs of a transfer do,
+// is debited from the counter account of their action. The two legs of an
+// exchange are in different currencies and each balances against it.
+func Post(ctx context.Context, tx *gorm.DB, reference string, records []*dbModels.TransactionRecordModel) error {
+	return post(ctx, tx, reference, records, false)
+}
+
+// Reverse journals the opposite of Post for the records.
+func Reverse(ctx context.Context, tx *gorm.DB, reference string, records []*dbModels.TransactionRecordModel) error {
+	return post(ctx, tx, reference, records, true)
+}
+
+func post(ctx context.Context, tx *gorm.DB, reference string, records []*dbModels.TransactionRecordModel, reverse bool) error {
+	currencies := []string{}
+	byCurrency := map[string][]*dbModels.TransactionRecordModel{}
+	for _, record := range records {
+		if _, ok := byCurrency[record.Currency]; !ok {
+			currencies = append(currencies, record.Currency)
+		}
+		byCurrency[record.Currency] = append(byCurrency[record.Currency], record)
+	}
+
+	for _, currency := range currencies {
+		if err := postEntry(ctx, tx, reference, currency, byCurrency[currency], reverse); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func postEntry(ctx context.Context, tx *gorm.DB, reference string, currency string, records []*dbModels.TransactionRecordModel, reverse bool) error {
+
+	lines := make([]*dbModels.JournalLineModel, 0, len(records)+1)
+	net := decimal.Zero
+	for _, record := range records {
+		account, err := WalletAccount(tx, record.WalletID, currency)
+		if err != nil {
+			logging.Error(ctx, "[ledger] failed to get account of wallet %d: %v", record.WalletID, err)
+			return err
+		}
+		amount := record.Amount
+		if reverse {
+			amount = amount.Neg()
+		}
+		line := newLine(account, amount)
+		line.TransactionRecordID = sql.NullInt64{
+			Valid: true,
+			Int64: int64(record.ID),
+		}
+		lines = append(lines, line)
+		net = net.Add(amount)
+	}
+
+	if !net.IsZero() {
+		account, err := SystemAccount(tx, CounterAccount(records[0].Action), currency)
+		if err != nil {
+			logging.Error(ctx, "[ledger] failed to get system account: %v", err)
+			return err
+		}
+		lines = append(lines, newLine(account, net.Neg()))
+	}
+
+	entry := &dbModels.JournalEntryModel{
+		Reference: reference,
+		Currency:  currency,
+	}
+	if _, err := journalEntryDao.New(tx, entry); err != nil {
+		logging.Error(ctx, "[ledger] failed to new journal entry %s: %v", reference, err)
+		return err
+	}
+	for _, line := range lines {
+		line.JournalEntryID = entry.ID
+	}
+	if _, err := journalLineDao.News(tx, lines); err != nil {
+		logging.Error(ctx, "[ledger] failed to new journal lines of %s: %v", reference, err)
+		return err
+	}
+	return nil
+}
+
+// newLine credits a positive amount to the account and debits a negative one.
+func newLine(account *dbModels.LedgerAccountModel, amount decimal.Decimal) *dbModels.JournalLineModel {
+	line := &dbModels.JournalLineModel{
+		AccountID: account.ID,
+		Currency:  account.Currency,
+		Debit:     decimal.Zero,
+		Credit:    decimal.Zero,
+	}
+	if amount.IsNegative() {
+		line.Debit = amount.Neg()
+	} else {
+		line.Credit = amount
+	}
+	return line
+}
+
+// WalletAccount returns the ledger account of the wallet, opening it if needed.
+func WalletAccount(tx *gorm.DB, walletID uint64, currency string) (*dbModels.LedgerAccountModel, error) {
+	return account(tx, &dbModels.LedgerAccountModel{
+		Code: fmt.Sprintf("wallet:%d", walletID),
+		Type: dbModels.LedgerAccountType_Wallet,
+		WalletID: sql.NullInt64{
+			Valid: true,
+			Int64: int64(walletID),
+		},
+		Currency: currency,
+	})
+}
+
+// SystemAccount returns the system account of the currency, opening it if needed.
+func SystemAccount(tx *gorm.DB, code string, currency string) (*dbModels.LedgerAccountModel, error) {
+	return account(tx, &dbModels.LedgerAccountModel{
+		Code:     code,
+		Type:     dbModels.LedgerAccountType_System,
+		Currency: currency,
+	})
+}
+
+func account(tx *gorm.DB, model *dbModels.LedgerAccountModel) (*dbModels.LedgerAccountModel, error) {
+	query := &ledgerAccountDao.QueryModel{
+		Code:     &model.Code,
+		Currency: &model.Currency,
+	}
+	account, err := ledgerAccountDao.Get(tx, query)
+	if err != nil || account != nil {
+		return account, err
+	}
+	if _, err := ledgerAccountDao.NewIfNotExist(tx, model); err != nil {
+		return nil, err
+	}
+
+	// an account opened by a concurrent transaction is not in the snapshot of
+	// this one, but a locking read sees it once that transaction commits
+	query.ForShare = true
+	account, err = ledgerAccountDao.Get(tx, query)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, models.ErrNoSuchLedgerAccount
+	}
+	return account, nil
+}
@@ -0,0 +1,36 @@
+package rate
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/shopspring/decimal"
+)
+
+// FileRate is a rate in a static rate file, which is a JSON array of them:
+//
+//	[{"base": "USD", "quote": "TWD", "rate": "30.5"}]
+type FileRate struct {
+	Base  string          `json:"base"`
+	Quote string          `json:"quote"`
+	Rate  decimal.Decimal `json:"rate"`
+}
+
+// NewStaticFile quotes from the rates of the file at path.
+func NewStaticFile(path string) (*Static, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fileRates := []FileRate{}
+	if err := json.Unmarshal(content, &fileRates); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[Pair]decimal.Decimal, len(fileRates))
+	for _, r := range fileRates {
+		rates[Pair{Base: r.Base, Quote: r.Quote}] = r.Rate
+	}
+	return NewStatic(rates), nil
+}
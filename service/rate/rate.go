@@ -0,0 +1,59 @@
+package rate
+
+import (
+	"context"
+
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/shopspring/decimal"
+)
+
+// Decimals is the precision of exchange rates in the database.
+const Decimals = 12
+
+// Provider quotes exchange rates between currencies.
+type Provider interface {
+	// Rate returns how much of quote one unit of base buys.
+	Rate(ctx context.Context, base string, quote string) (decimal.Decimal, error)
+}
+
+// Pair is a base currency quoted in another.
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// Static quotes from a fixed table of rates. A pair missing from the table is
+// quoted by the inverse of the opposite pair.
+type Static struct {
+	rates map[Pair]decimal.Decimal
+}
+
+func NewStatic(rates map[Pair]decimal.Decimal) *Static {
+	s := &Static{
+		rates: map[Pair]decimal.Decimal{},
+	}
+	for pair, rate := range rates {
+		if rate.IsPositive() {
+			s.rates[pair] = rate
+		}
+	}
+	return s
+}
+
+func (s *Static) Rate(ctx context.Context, base string, quote string) (decimal.Decimal, error) {
+	if base == quote {
+		return decimal.NewFromInt(1), nil
+	}
+	if rate, ok := s.rates[Pair{Base: base, Quote: quote}]; ok {
+		return rate, nil
+	}
+	if rate, ok := s.rates[Pair{Base: quote, Quote: base}]; ok {
+		return Inverse(rate), nil
+	}
+	return decimal.Zero, models.ErrNoSuchExchangeRate
+}
+
+// Inverse returns the rate of the opposite pair.
+func Inverse(rate decimal.Decimal) decimal.Decimal {
+	return decimal.NewFromInt(1).DivRound(rate, Decimals)
+}
@@ -0,0 +1,102 @@
+package rate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/shopspring/decimal"
+)
+
+func TestInverse(t *testing.T) {
+	tests := []struct {
+		rate string
+		want string
+	}{
+		{"1", "1"},
+		{"2", "0.5"},
+		{"0.5", "2"},
+		{"30.5", "0.032786885246"},
+		{"3", "0.333333333333"},
+		{"1.5", "0.666666666667"},
+	}
+
+	for _, tt := range tests {
+		if got := Inverse(decimal.RequireFromString(tt.rate)); !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("Inverse(%s) = %s, want %s", tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestStaticRate(t *testing.T) {
+	static := NewStatic(map[Pair]decimal.Decimal{
+		{Base: "USD", Quote: "TWD"}: decimal.RequireFromString("30.5"),
+		{Base: "TWD", Quote: "JPY"}: decimal.RequireFromString("4.5"),
+		{Base: "JPY", Quote: "TWD"}: decimal.RequireFromString("0.2"),
+		{Base: "EUR", Quote: "USD"}: decimal.Zero,
+	})
+
+	tests := []struct {
+		name  string
+		base  string
+		quote string
+		want  string
+		err   error
+	}{
+		{"same currency", "BTC", "BTC", "1", nil},
+		{"quoted pair", "USD", "TWD", "30.5", nil},
+		{"inverse of the opposite pair", "TWD", "USD", "0.032786885246", nil},
+		{"quoted pair over its inverse", "JPY", "TWD", "0.2", nil},
+		{"no pair", "USD", "JPY", "0", models.ErrNoSuchExchangeRate},
+		{"rate that is not positive dropped", "EUR", "USD", "0", models.ErrNoSuchExchangeRate},
+		{"inverse of a dropped rate", "USD", "EUR", "0", models.ErrNoSuchExchangeRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := static.Rate(context.Background(), tt.base, tt.quote)
+			if err != tt.err {
+				t.Errorf("Rate(%s, %s) error = %v, want %v", tt.base, tt.quote, err, tt.err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("Rate(%s, %s) = %s, want %s", tt.base, tt.quote, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewStaticFile(t *testing.T) {
+	static, err := NewStaticFile("testdata/rates.json")
+	if err != nil {
+		t.Fatalf("failed to read rates: %v", err)
+	}
+
+	tests := []struct {
+		base  string
+		quote string
+		want  string
+		err   error
+	}{
+		{"USD", "TWD", "30.5", nil},
+		{"BTC", "USD", "27000", nil},
+		{"USD", "BTC", "0.000037037037", nil},
+		{"JPY", "USD", "0", models.ErrNoSuchExchangeRate},
+		{"BTC", "TWD", "0", models.ErrNoSuchExchangeRate},
+	}
+
+	for _, tt := range tests {
+		got, err := static.Rate(context.Background(), tt.base, tt.quote)
+		if err != tt.err {
+			t.Errorf("Rate(%s, %s) error = %v, want %v", tt.base, tt.quote, err, tt.err)
+		}
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("Rate(%s, %s) = %s, want %s", tt.base, tt.quote, got, tt.want)
+		}
+	}
+
+	for _, path := range []string{"testdata/missing.json", "testdata/malformed.json"} {
+		if _, err := NewStaticFile(path); err == nil {
+			t.Errorf("NewStaticFile(%s) read rates, want an error", path)
+		}
+	}
+}
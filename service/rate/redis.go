@@ -0,0 +1,70 @@
+package rate
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/shopspring/decimal"
+)
+
+// Key is the redis key the rate feed writes the rate of base in quote to. The
+// feed sets it with an expiry so that a stalled feed stops quoting.
+func Key(base string, quote string) string {
+	return "rate:" + base + ":" + quote
+}
+
+// Redis quotes the latest rates of the feed in redis. A pair missing from the
+// feed is quoted by the inverse of the opposite pair.
+type Redis struct {
+	client func() (redis.Cmdable, error)
+}
+
+// NewRedis quotes from the redis of client, which is called on every quote so
+// that a reconnected client is picked up.
+func NewRedis(client func() (redis.Cmdable, error)) *Redis {
+	return &Redis{
+		client: client,
+	}
+}
+
+func (r *Redis) Rate(ctx context.Context, base string, quote string) (decimal.Decimal, error) {
+	if base == quote {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := r.get(ctx, Key(base, quote))
+	if !errors.Is(err, models.ErrNoSuchExchangeRate) {
+		return rate, err
+	}
+	rate, err = r.get(ctx, Key(quote, base))
+	if err != nil {
+		return rate, err
+	}
+	return Inverse(rate), nil
+}
+
+func (r *Redis) get(ctx context.Context, key string) (decimal.Decimal, error) {
+	client, err := r.client()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	value, err := client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return decimal.Zero, models.ErrNoSuchExchangeRate
+	}
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	rate, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !rate.IsPositive() {
+		return decimal.Zero, models.ErrNoSuchExchangeRate
+	}
+	return rate, nil
+}
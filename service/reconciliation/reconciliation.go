@@ -0,0 +1,104 @@
+package reconciliation
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+)
+
+// balanceChange is one change of the wallet amount made by a transaction
+// record, either applying it or rolling it back.
+type balanceChange struct {
+	recordID uint64
+	before   decimal.NullDecimal
+	after    decimal.NullDecimal
+	version  sql.NullInt64
+	at       time.Time
+}
+
+// Wallet replays the successful and rolled back transaction records of the
+// wallet, returning a discrepancy if their sum is not the wallet amount, and one
+// for each change whose before amount is not the after amount of the previous
+// change.
+func Wallet(runID string, walletModel *dbModels.WalletModel, records []*dbModels.TransactionRecordModel) []*dbModels.ReconciliationDiscrepancyModel {
+
+	discrepancy := func(discrepancyType dbModels.ReconciliationDiscrepancyType, recordID uint64, expected, actual decimal.Decimal) *dbModels.ReconciliationDiscrepancyModel {
+		d := &dbModels.ReconciliationDiscrepancyModel{
+			RunID:          runID,
+			MemberID:       walletModel.MemberID,
+			WalletID:       walletModel.ID,
+			Type:           discrepancyType,
+			ExpectedAmount: expected,
+			ActualAmount:   actual,
+			Currency:       walletModel.Currency,
+		}
+		if recordID != 0 {
+			d.TransactionRecordID = sql.NullInt64{
+				Valid: true,
+				Int64: int64(recordID),
+			}
+		}
+		return d
+	}
+
+	// a rolled back record is applied and then reversed, adding nothing
+	expected := decimal.Zero
+	changes := make([]*balanceChange, 0, len(records))
+	for _, r := range records {
+		changes = append(changes, &balanceChange{
+			recordID: r.ID,
+			before:   r.BeforeAmount,
+			after:    r.AfterAmount,
+			version:  r.WalletVersion,
+			at:       r.CreatedAt,
+		})
+		if r.Status == dbModels.TransactionStatus_Success {
+			expected = expected.Add(r.Amount)
+			continue
+		}
+		changes = append(changes, &balanceChange{
+			recordID: r.ID,
+			before:   r.RollbackBeforeAmount,
+			after:    r.RollbackAfterAmount,
+			version:  r.RollbackWalletVersion,
+			at:       r.UpdatedAt,
+		})
+	}
+
+	discrepancies := []*dbModels.ReconciliationDiscrepancyModel{}
+	if !expected.Equal(walletModel.Amount) {
+		discrepancies = append(discrepancies, discrepancy(dbModels.ReconciliationDiscrepancyType_BalanceMismatch, 0, expected, walletModel.Amount))
+	}
+
+	// changes made before wallets were versioned can only be ordered by time,
+	// and all come before the versioned ones
+	sort.SliceStable(changes, func(i, j int) bool {
+		ci, cj := changes[i], changes[j]
+		if ci.version.Valid != cj.version.Valid {
+			return !ci.version.Valid
+		}
+		if ci.version.Valid {
+			return ci.version.Int64 < cj.version.Int64
+		}
+		if !ci.at.Equal(cj.at) {
+			return ci.at.Before(cj.at)
+		}
+		return ci.recordID < cj.recordID
+	})
+
+	previous := decimal.Zero
+	for _, c := range changes {
+		if !c.before.Valid || !c.after.Valid {
+			continue
+		}
+		if !c.before.Decimal.Equal(previous) {
+			discrepancies = append(discrepancies, discrepancy(dbModels.ReconciliationDiscrepancyType_ChainBroken, c.recordID, previous, c.before.Decimal))
+		}
+		previous = c.after.Decimal
+	}
+
+	return discrepancies
+}
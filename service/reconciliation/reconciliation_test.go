@@ -0,0 +1,159 @@
+package reconciliation
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+)
+
+func TestWallet(t *testing.T) {
+	start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	// applied moves the wallet from before to after at the version
+	applied := func(id uint64, amount, before, after string, version int64) *dbModels.TransactionRecordModel {
+		return &dbModels.TransactionRecordModel{
+			ID:            id,
+			Amount:        decimal.RequireFromString(amount),
+			Status:        dbModels.TransactionStatus_Success,
+			BeforeAmount:  decimal.NewNullDecimal(decimal.RequireFromString(before)),
+			AfterAmount:   decimal.NewNullDecimal(decimal.RequireFromString(after)),
+			WalletVersion: sql.NullInt64{Valid: version > 0, Int64: version},
+			CreatedAt:     start.Add(time.Duration(id) * time.Minute),
+		}
+	}
+	// rolledBack is applied, then reversed from rollbackBefore at the version
+	rolledBack := func(id uint64, amount, before, after, rollbackBefore string, version, rollbackVersion int64) *dbModels.TransactionRecordModel {
+		r := applied(id, amount, before, after, version)
+		r.Status = dbModels.TransactionStatus_Rollback
+		rollbackAfter := decimal.RequireFromString(rollbackBefore).Sub(r.Amount)
+		r.RollbackBeforeAmount = decimal.NewNullDecimal(decimal.RequireFromString(rollbackBefore))
+		r.RollbackAfterAmount = decimal.NewNullDecimal(rollbackAfter)
+		r.RollbackWalletVersion = sql.NullInt64{Valid: rollbackVersion > 0, Int64: rollbackVersion}
+		r.UpdatedAt = r.CreatedAt.Add(time.Hour)
+		return r
+	}
+
+	type found struct {
+		discrepancyType dbModels.ReconciliationDiscrepancyType
+		recordID        uint64
+		expected        string
+		actual          string
+	}
+
+	tests := []struct {
+		name    string
+		amount  string
+		records []*dbModels.TransactionRecordModel
+		want    []found
+	}{
+		{
+			name:   "no records",
+			amount: "0",
+		},
+		{
+			name:   "consistent chain",
+			amount: "70",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				applied(2, "-30", "100", "70", 2),
+			},
+		},
+		{
+			name:   "records read out of version order",
+			amount: "70",
+			records: []*dbModels.TransactionRecordModel{
+				applied(2, "-30", "100", "70", 2),
+				applied(1, "100", "0", "100", 1),
+			},
+		},
+		{
+			name:   "balance mismatch",
+			amount: "75",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				applied(2, "-30", "100", "70", 2),
+			},
+			want: []found{
+				{dbModels.ReconciliationDiscrepancyType_BalanceMismatch, 0, "70", "75"},
+			},
+		},
+		{
+			name:   "chain broken",
+			amount: "80",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				applied(2, "-20", "110", "90", 2),
+			},
+			want: []found{
+				{dbModels.ReconciliationDiscrepancyType_ChainBroken, 2, "100", "110"},
+			},
+		},
+		{
+			name:   "rollback adds nothing",
+			amount: "100",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				rolledBack(2, "50", "100", "150", "150", 2, 3),
+			},
+		},
+		{
+			name:   "rollback after a later transaction",
+			amount: "90",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				rolledBack(2, "50", "100", "150", "140", 2, 4),
+				applied(3, "-10", "150", "140", 3),
+			},
+		},
+		{
+			name:   "unversioned changes come first, by time",
+			amount: "130",
+			records: []*dbModels.TransactionRecordModel{
+				applied(3, "30", "100", "130", 1),
+				applied(2, "40", "60", "100", 0),
+				applied(1, "60", "0", "60", 0),
+			},
+		},
+		{
+			name:   "change without amounts skipped",
+			amount: "130",
+			records: []*dbModels.TransactionRecordModel{
+				applied(1, "100", "0", "100", 1),
+				{
+					ID:     2,
+					Amount: decimal.RequireFromString("30"),
+					Status: dbModels.TransactionStatus_Success,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			walletModel := &dbModels.WalletModel{
+				ID:       7,
+				MemberID: 3,
+				Currency: "USD",
+				Amount:   decimal.RequireFromString(tt.amount),
+			}
+			got := Wallet("run", walletModel, tt.records)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d discrepancies, want %d", len(got), len(tt.want))
+			}
+			for i, d := range got {
+				w := tt.want[i]
+				if d.Type != w.discrepancyType || uint64(d.TransactionRecordID.Int64) != w.recordID ||
+					!d.ExpectedAmount.Equal(decimal.RequireFromString(w.expected)) || !d.ActualAmount.Equal(decimal.RequireFromString(w.actual)) {
+					t.Errorf("discrepancy %d is type %d of record %d, %s expected, %s actual, want %+v",
+						i, d.Type, d.TransactionRecordID.Int64, d.ExpectedAmount, d.ActualAmount, w)
+				}
+				if d.RunID != "run" || d.WalletID != walletModel.ID || d.MemberID != walletModel.MemberID || d.Currency != walletModel.Currency {
+					t.Errorf("discrepancy %d is of run %s, wallet %d, member %d in %s", i, d.RunID, d.WalletID, d.MemberID, d.Currency)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// GetBalanceAt reconstructs the wallet amount at a past time from the
+// transaction records.
+func (impl *WalletImpl) GetBalanceAt(ctx context.Context, in *wallet.GetBalanceAtReq) (*wallet.GetBalanceAtRes, error) {
+
+	db := database.GetDB()
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{in.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetBalanceAt] failed to get wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		logging.Error(ctx, "[GetBalanceAt] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+
+	amount, err := balanceAt(db, in.WalletID, time.Unix(in.Timestamp, 0))
+	if err != nil {
+		logging.Error(ctx, "[GetBalanceAt] failed to get balance of wallet %d at %d: %v", in.WalletID, in.Timestamp, err)
+		return nil, err
+	}
+
+	return &wallet.GetBalanceAtRes{
+		WalletID:  walletModel.ID,
+		Currency:  walletModel.Currency,
+		Amount:    amount.String(),
+		Timestamp: in.Timestamp,
+	}, nil
+}
+
+// balanceAt is the amount left by the last change of the wallet until at,
+// either a record applied or a record rolled back.
+func balanceAt(db *gorm.DB, walletID uint64, at time.Time) (decimal.Decimal, error) {
+
+	applied, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		WalletID: &walletID,
+		Status: []dbModels.TransactionStatus{
+			dbModels.TransactionStatus_Success,
+			dbModels.TransactionStatus_Rollback,
+		},
+		AppliedUntil: &at,
+		OrderBy: []*transactionRecordDao.Order{
+			{Column: transactionRecordDao.OrderColumn_AppliedAt, Direction: transactionRecordDao.OrderDirection_DESC},
+			{Column: transactionRecordDao.OrderColumn_WalletVersion, Direction: transactionRecordDao.OrderDirection_DESC},
+			{Column: transactionRecordDao.OrderColumn_ID, Direction: transactionRecordDao.OrderDirection_DESC},
+		},
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	rolledBack, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		WalletID:        &walletID,
+		Status:          []dbModels.TransactionStatus{dbModels.TransactionStatus_Rollback},
+		RolledBackUntil: &at,
+		OrderBy: []*transactionRecordDao.Order{
+			{Column: transactionRecordDao.OrderColumn_RolledBackAt, Direction: transactionRecordDao.OrderDirection_DESC},
+			{Column: transactionRecordDao.OrderColumn_RollbackWalletVersion, Direction: transactionRecordDao.OrderDirection_DESC},
+			{Column: transactionRecordDao.OrderColumn_ID, Direction: transactionRecordDao.OrderDirection_DESC},
+		},
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch {
+	case applied == nil && rolledBack == nil:
+		return decimal.Zero, nil
+	case rolledBack == nil:
+		return applied.AfterAmount.Decimal, nil
+	case applied == nil:
+		return rolledBack.RollbackAfterAmount.Decimal, nil
+	}
+
+	// versions order changes exactly, timestamps only to the second
+	if applied.WalletVersion.Valid && rolledBack.RollbackWalletVersion.Valid {
+		if applied.WalletVersion.Int64 > rolledBack.RollbackWalletVersion.Int64 {
+			return applied.AfterAmount.Decimal, nil
+		}
+		return rolledBack.RollbackAfterAmount.Decimal, nil
+	}
+	if applied.AppliedAt.Time.After(rolledBack.RolledBackAt.Time) {
+		return applied.AfterAmount.Decimal, nil
+	}
+	return rolledBack.RollbackAfterAmount.Decimal, nil
+}
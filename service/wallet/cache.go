@@ -0,0 +1,222 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"gorm.io/gorm"
+)
+
+// The wallet cache serves GetWallets. A wallet is cached under its id as
+// "<version>:<json>", and a cached wallet is only ever replaced by a newer
+// version, so a reader filling in what it read before a change can not
+// overwrite the change. A deleted wallet is cached as "<version>:" with a
+// version no wallet reaches. A wallet that could not be refreshed after a
+// change is cached as stale, with the version just below, so that it is read
+// from the database until the mark expires. The key of a member and currency
+// holds the id of the wallet.
+const (
+	walletCacheExpiry = 10 * time.Minute
+	// the largest integer redis scripts compare exactly
+	deletedWalletVersion = 1 << 53
+	staleWalletVersion   = deletedWalletVersion - 1
+	staleWalletPayload   = "-"
+	// outlasts the reads that started before the change and fill the cache
+	// with what they read
+	staleWalletExpiry = time.Minute
+)
+
+var (
+	walletCacheHits   = expvar.NewInt("walletCacheHits")
+	walletCacheMisses = expvar.NewInt("walletCacheMisses")
+	// changed wallets neither refreshed, marked stale nor evicted, which the
+	// cache may serve as they were until walletCacheExpiry
+	walletCacheStale = expvar.NewInt("walletCacheStale")
+)
+
+func init() {
+	expvar.Publish("walletCacheHitRate", expvar.Func(func() interface{} {
+		hits, misses := walletCacheHits.Value(), walletCacheMisses.Value()
+		if hits+misses == 0 {
+			return 0.0
+		}
+		return float64(hits) / float64(hits+misses)
+	}))
+}
+
+// setIfNewer sets KEYS[1] to ARGV[1]:ARGV[2] for ARGV[3] milliseconds unless
+// it holds version ARGV[1] or a later one.
+var setIfNewer = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current then
+	local version = tonumber(string.match(current, '^(%d+):'))
+	if version and version >= tonumber(ARGV[1]) then
+		return 0
+	end
+end
+redis.call('SET', KEYS[1], ARGV[1] .. ':' .. ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+func walletCacheKey(walletID uint64) string {
+	return fmt.Sprintf("wallet:%d", walletID)
+}
+
+func memberWalletCacheKey(memberID uint64, code string) string {
+	return fmt.Sprintf("wallet:member:%d:%s", memberID, code)
+}
+
+// getCachedWallet returns the wallet from the cache, nil if it was deleted.
+// Found tells if the cache knew about the wallet at all.
+func getCachedWallet(ctx context.Context, walletID uint64) (walletModel *dbModels.WalletModel, found bool) {
+	r, _ := cache.GetRedis()
+	value, err := r.Get(ctx, walletCacheKey(walletID)).Result()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logging.Warn(ctx, "[getCachedWallet] failed to get cached wallet %d: %v", walletID, err)
+		}
+		return nil, false
+	}
+
+	_, payload, ok := strings.Cut(value, ":")
+	if !ok || payload == staleWalletPayload {
+		return nil, false
+	}
+	if payload == "" {
+		return nil, true
+	}
+	walletModel = &dbModels.WalletModel{}
+	if err := json.Unmarshal([]byte(payload), walletModel); err != nil {
+		logging.Warn(ctx, "[getCachedWallet] failed to unmarshal cached wallet %d: %v", walletID, err)
+		return nil, false
+	}
+	return walletModel, true
+}
+
+// cachedWallet returns the wallet of the id, reading it through the cache.
+func cachedWallet(ctx context.Context, db *gorm.DB, walletID uint64) (*dbModels.WalletModel, error) {
+	if walletModel, found := getCachedWallet(ctx, walletID); found {
+		walletCacheHits.Add(1)
+		return walletModel, nil
+	}
+	walletCacheMisses.Add(1)
+
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{walletID},
+	})
+	if err != nil || walletModel == nil {
+		return nil, err
+	}
+	cacheWallet(ctx, walletModel)
+	return walletModel, nil
+}
+
+// cachedMemberWallet returns the wallet of the member in the currency, reading
+// it through the cache.
+func cachedMemberWallet(ctx context.Context, db *gorm.DB, memberID uint64, code string) (*dbModels.WalletModel, error) {
+	r, _ := cache.GetRedis()
+	if walletID, err := r.Get(ctx, memberWalletCacheKey(memberID, code)).Uint64(); err == nil {
+		walletModel, found := getCachedWallet(ctx, walletID)
+		if found && walletModel != nil && walletModel.MemberID == memberID && walletModel.Currency == code {
+			walletCacheHits.Add(1)
+			return walletModel, nil
+		}
+	}
+	walletCacheMisses.Add(1)
+
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		MemberID: []uint64{memberID},
+		Currency: &code,
+	})
+	if err != nil || walletModel == nil {
+		return nil, err
+	}
+	cacheWallet(ctx, walletModel)
+	return walletModel, nil
+}
+
+// cacheWallet caches the wallet unless a newer version is cached already.
+func cacheWallet(ctx context.Context, walletModel *dbModels.WalletModel) error {
+	payload, err := json.Marshal(walletModel)
+	if err != nil {
+		logging.Error(ctx, "[cacheWallet] failed to marshal wallet %d: %v", walletModel.ID, err)
+		return err
+	}
+
+	r, _ := cache.GetRedis()
+	if err := setIfNewer.Run(ctx, r, []string{walletCacheKey(walletModel.ID)},
+		walletModel.Version, payload, walletCacheExpiry.Milliseconds()).Err(); err != nil {
+		logging.Warn(ctx, "[cacheWallet] failed to cache wallet %d: %v", walletModel.ID, err)
+		return err
+	}
+	if err := r.Set(ctx, memberWalletCacheKey(walletModel.MemberID, walletModel.Currency), walletModel.ID, walletCacheExpiry).Err(); err != nil {
+		logging.Warn(ctx, "[cacheWallet] failed to cache id of wallet %d: %v", walletModel.ID, err)
+		return err
+	}
+	return nil
+}
+
+// refreshWalletCache caches the wallets as committed. It is called once a
+// change of the wallets commits, before the change is reported done. A wallet
+// that can not be cached is marked stale instead, or evicted if even that
+// fails, so that it is read again. Failing both, redis is most likely down and
+// serving no one, which walletCacheStale counts.
+func refreshWalletCache(ctx context.Context, db *gorm.DB, walletID ...uint64) {
+	if len(walletID) == 0 {
+		return
+	}
+	walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{
+		ID: walletID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[refreshWalletCache] failed to get wallets %v: %v", walletID, err)
+	}
+
+	r, _ := cache.GetRedis()
+	refreshed := map[uint64]bool{}
+	for i := range walletModels {
+		if cacheWallet(ctx, &walletModels[i]) == nil {
+			refreshed[walletModels[i].ID] = true
+		}
+	}
+	for _, id := range walletID {
+		if refreshed[id] {
+			continue
+		}
+		err := setIfNewer.Run(ctx, r, []string{walletCacheKey(id)},
+			staleWalletVersion, staleWalletPayload, staleWalletExpiry.Milliseconds()).Err()
+		if err == nil {
+			continue
+		}
+		logging.Warn(ctx, "[refreshWalletCache] failed to mark wallet %d stale: %v", id, err)
+		if err := r.Del(ctx, walletCacheKey(id)).Err(); err != nil {
+			walletCacheStale.Add(1)
+			logging.Error(ctx, "[refreshWalletCache] failed to evict wallet %d, it may be served stale: %v", id, err)
+		}
+	}
+}
+
+// uncacheDeletedWallet caches the wallet as deleted once its deletion commits.
+func uncacheDeletedWallet(ctx context.Context, walletModel *dbModels.WalletModel) {
+	r, _ := cache.GetRedis()
+	if err := setIfNewer.Run(ctx, r, []string{walletCacheKey(walletModel.ID)},
+		deletedWalletVersion, "", walletCacheExpiry.Milliseconds()).Err(); err != nil {
+		logging.Error(ctx, "[uncacheDeletedWallet] failed to cache wallet %d as deleted: %v", walletModel.ID, err)
+		if err := r.Del(ctx, walletCacheKey(walletModel.ID)).Err(); err != nil {
+			logging.Error(ctx, "[uncacheDeletedWallet] failed to evict wallet %d: %v", walletModel.ID, err)
+		}
+	}
+	if err := r.Del(ctx, memberWalletCacheKey(walletModel.MemberID, walletModel.Currency)).Err(); err != nil {
+		logging.Error(ctx, "[uncacheDeletedWallet] failed to evict id of wallet %d: %v", walletModel.ID, err)
+	}
+}
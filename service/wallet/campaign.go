@@ -0,0 +1,297 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/bonusCampaignDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+func (impl *WalletImpl) CreateBonusCampaign(ctx context.Context, in *wallet.CreateBonusCampaignReq) (*wallet.CreateBonusCampaignRes, error) {
+
+	db := database.GetDB()
+	budget, err := decimal.NewFromString(in.Budget)
+	if err != nil {
+		logging.Error(ctx, "[CreateBonusCampaign] failed to cast budget to decimal: %v", err)
+		return nil, err
+	}
+	memberCap, err := decimal.NewFromString(in.MemberCap)
+	if err != nil {
+		logging.Error(ctx, "[CreateBonusCampaign] failed to cast member cap to decimal: %v", err)
+		return nil, err
+	}
+	if in.Name == "" || in.Currency == "" || !budget.IsPositive() || !memberCap.IsPositive() || in.StartAt >= in.EndAt {
+		logging.Error(ctx, "[CreateBonusCampaign] invalid campaign %s: %v", in.Name, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	campaign := &dbModels.BonusCampaignModel{
+		Name:        in.Name,
+		Currency:    in.Currency,
+		Budget:      budget,
+		MemberCap:   memberCap,
+		StartAt:     time.Unix(in.StartAt, 0),
+		EndAt:       time.Unix(in.EndAt, 0),
+		CommitterID: in.CommitterID,
+	}
+	if _, err := bonusCampaignDao.New(db, campaign); err != nil {
+		logging.Error(ctx, "[CreateBonusCampaign] failed to new campaign: %v", err)
+		return nil, err
+	}
+
+	return &wallet.CreateBonusCampaignRes{
+		Id: campaign.ID,
+	}, nil
+}
+
+func (impl *WalletImpl) GetBonusCampaigns(ctx context.Context, in *wallet.GetBonusCampaignsReq) (*wallet.GetBonusCampaignsRes, error) {
+
+	db := database.GetDB()
+	query := &bonusCampaignDao.QueryModel{
+		ID:       in.Id,
+		Currency: in.Currency,
+	}
+	if in.ActiveAt != nil {
+		activeAt := time.Unix(*in.ActiveAt, 0)
+		query.ActiveAt = &activeAt
+	}
+
+	models, paginationInfo, err := bonusCampaignDao.GetsWithPagination(db, query, in.Pagination)
+	if err != nil {
+		logging.Error(ctx, "[GetBonusCampaigns] failed to get campaigns: %v", err)
+		return nil, err
+	}
+
+	res := &wallet.GetBonusCampaignsRes{
+		PaginationInfo: paginationInfo,
+	}
+	for _, m := range models {
+		granted, err := transactionRecordDao.SumAmount(db, &transactionRecordDao.QueryModel{
+			CampaignID: &m.ID,
+			Status:     []dbModels.TransactionStatus{dbModels.TransactionStatus_Success},
+		})
+		if err != nil {
+			logging.Error(ctx, "[GetBonusCampaigns] failed to sum granted amount of campaign %d: %v", m.ID, err)
+			return nil, err
+		}
+		res.Campaigns = append(res.Campaigns, &wallet.BonusCampaign{
+			Id:            m.ID,
+			Name:          m.Name,
+			Currency:      m.Currency,
+			Budget:        m.Budget.String(),
+			MemberCap:     m.MemberCap.String(),
+			GrantedAmount: granted.String(),
+			StartAt:       m.StartAt.Unix(),
+			EndAt:         m.EndAt.Unix(),
+			CommitterID:   m.CommitterID,
+			CreatedAt:     m.CreatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+// GrantCampaignBonus credits a Bonus transaction tagged with the campaign,
+// within its window, budget and member cap. A rolled back bonus no longer
+// counts against the limits.
+func (impl *WalletImpl) GrantCampaignBonus(ctx context.Context, in *wallet.GrantCampaignBonusReq) (*wallet.TransactionRes, error) {
+
+	db := database.GetDB()
+	amount, err := decimal.NewFromString(in.Amount)
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] failed to cast amount to decimal: %v", err)
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		logging.Error(ctx, "[GrantCampaignBonus] invalid bonus amount %s: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	key, err := idempotencyKey(in.IdempotencyKey)
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] invalid idempotency key: %v", err)
+		return nil, err
+	}
+	granted := func() (*dbModels.TransactionRecordModel, error) {
+		if key == "" {
+			return nil, nil
+		}
+		record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			IdempotencyKey: &key,
+		})
+		if err != nil {
+			logging.Error(ctx, "[GrantCampaignBonus] failed to get transaction record of idempotency key %s: %v", key, err)
+			return nil, err
+		}
+		if record == nil {
+			return nil, nil
+		}
+		// a campaign grants in its one currency
+		if !record.CampaignID.Valid || uint64(record.CampaignID.Int64) != in.CampaignID {
+			logging.Error(ctx, "[GrantCampaignBonus] idempotency key %s of transaction %d replayed by another request: %v", key, record.ID, models.ErrIdempotencyKeyConflict)
+			return nil, models.ErrIdempotencyKeyConflict
+		}
+		if err := checkReplay(ctx, db, key, record, false, in.WalletID, wallet.Action(dbModels.TransactionAction_Bonus), record.Currency, amount); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+	record, err := granted()
+	if err != nil {
+		return nil, err
+	}
+	if record != nil {
+		logging.Info(ctx, "[GrantCampaignBonus] idempotency key %s already used by transaction %d", key, record.ID)
+		return transactionRes(record), nil
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	campaign, err := bonusCampaignDao.Get(db, &bonusCampaignDao.QueryModel{
+		ID: &in.CampaignID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] failed to get campaign %d: %v", in.CampaignID, err)
+		return nil, err
+	}
+	if campaign == nil {
+		logging.Error(ctx, "[GrantCampaignBonus] no such campaign %d: %v", in.CampaignID, models.ErrNoSuchCampaign)
+		return nil, models.ErrNoSuchCampaign
+	}
+	if !campaign.Active(time.Now()) {
+		logging.Error(ctx, "[GrantCampaignBonus] campaign %d is not active: %v", in.CampaignID, models.ErrCampaignNotActive)
+		return nil, models.ErrCampaignNotActive
+	}
+	if amount.GreaterThan(campaign.MemberCap) {
+		logging.Error(ctx, "[GrantCampaignBonus] bonus %s over member cap %s of campaign %d: %v", in.Amount, campaign.MemberCap.String(), campaign.ID, models.ErrCampaignCapExceeded)
+		return nil, models.ErrCampaignCapExceeded
+	}
+
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{in.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] failed to get wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		logging.Error(ctx, "[GrantCampaignBonus] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	amount, err = walletAmount(ctx, db, walletModel, campaign.Currency, amount)
+	if err != nil {
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		logging.Error(ctx, "[GrantCampaignBonus] bonus amount %s rounds to nothing: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	transactionRecord := &dbModels.TransactionRecordModel{
+		MemberID:    walletModel.MemberID,
+		WalletID:    walletModel.ID,
+		Action:      dbModels.TransactionAction_Bonus,
+		Amount:      amount,
+		Currency:    campaign.Currency,
+		CommitterID: in.CommitterID,
+		Status:      dbModels.TransactionStatus_Pending,
+		CampaignID: sql.NullInt64{
+			Valid: true,
+			Int64: int64(campaign.ID),
+		},
+	}
+	if in.Remark != nil {
+		transactionRecord.Remark = sql.NullString{
+			Valid:  true,
+			String: *in.Remark,
+		}
+	}
+	if key != "" {
+		transactionRecord.IdempotencyKey = sql.NullString{
+			Valid:  true,
+			String: key,
+		}
+	}
+
+	if _, err := transactionRecordDao.New(db, transactionRecord); err != nil {
+		// a concurrent retry with the same key may have won the unique index
+		record, replayErr := granted()
+		if replayErr != nil {
+			return nil, replayErr
+		}
+		if record != nil {
+			return transactionRes(record), nil
+		}
+		logging.Error(ctx, "[GrantCampaignBonus] failed to new transaction record: %v", err)
+		return nil, err
+	}
+
+	records := []*dbModels.TransactionRecordModel{transactionRecord}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// grants of a campaign queue on its row, so the sums below include
+		// every grant committed before
+		if _, err := bonusCampaignDao.Get(tx, &bonusCampaignDao.QueryModel{
+			ID:        &campaign.ID,
+			ForUpdate: true,
+		}); err != nil {
+			return err
+		}
+
+		success := []dbModels.TransactionStatus{dbModels.TransactionStatus_Success}
+		totalGranted, err := transactionRecordDao.SumAmount(tx, &transactionRecordDao.QueryModel{
+			CampaignID: &campaign.ID,
+			Status:     success,
+		})
+		if err != nil {
+			return err
+		}
+		if totalGranted.Add(amount).GreaterThan(campaign.Budget) {
+			return models.ErrCampaignBudgetExceeded
+		}
+
+		memberGranted, err := transactionRecordDao.SumAmount(tx, &transactionRecordDao.QueryModel{
+			CampaignID: &campaign.ID,
+			MemberID:   &walletModel.MemberID,
+			Status:     success,
+		})
+		if err != nil {
+			return err
+		}
+		if memberGranted.Add(amount).GreaterThan(campaign.MemberCap) {
+			return models.ErrCampaignCapExceeded
+		}
+
+		return applyRecords(ctx, tx, records, nil)
+	})
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] failed to grant bonus of campaign %d: %v", campaign.ID, err)
+		failRecords(ctx, db, records)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, transactionRecord.WalletID)
+
+	transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		ID: &transactionRecord.ID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GrantCampaignBonus] failed to get modified transaction record: %v", err)
+		return nil, err
+	}
+
+	return transactionRes(transactionRecord), nil
+}
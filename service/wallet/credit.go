@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"context"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SetCreditLimitReq lets the wallet amount go down to minus CreditLimit. A
+// lower limit stops further debits but leaves the amount as it is.
+type SetCreditLimitReq struct {
+	WalletID    uint64
+	CreditLimit string
+}
+
+type SetCreditLimitRes struct{}
+
+func (impl *WalletImpl) SetCreditLimit(ctx context.Context, in *SetCreditLimitReq) (*SetCreditLimitRes, error) {
+
+	db := database.GetDB()
+	creditLimit, err := decimal.NewFromString(in.CreditLimit)
+	if err != nil {
+		logging.Error(ctx, "[SetCreditLimit] failed to cast credit limit to decimal: %v", err)
+		return nil, err
+	}
+	if creditLimit.IsNegative() {
+		logging.Error(ctx, "[SetCreditLimit] negative credit limit %s: %v", in.CreditLimit, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		_, err := updateWallet(ctx, tx, in.WalletID, func(_ *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+			return &walletDao.UpdateModel{
+				CreditLimit: &creditLimit,
+			}, nil
+		})
+		return err
+	})
+	if err != nil {
+		logging.Error(ctx, "[SetCreditLimit] failed to set credit limit of wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, in.WalletID)
+
+	return &SetCreditLimitRes{}, nil
+}
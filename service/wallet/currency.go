@@ -0,0 +1,165 @@
+package wallet
+
+import (
+	"context"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/currencyDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/currency"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Currency is a currency wallets can hold. Amounts are rounded to Decimals,
+// and a single transaction moves between MinAmount and MaxAmount. New members
+// are provisioned a wallet of each Provision currency, credited StartingAmount.
+type Currency struct {
+	Code           string
+	Name           string
+	Decimals       int32
+	MinAmount      *string
+	MaxAmount      *string
+	Enabled        bool
+	Provision      bool
+	StartingAmount *string
+}
+
+// SetCurrencyReq adds the currency or replaces the one of the same code.
+type SetCurrencyReq struct {
+	Currency *Currency
+}
+
+type SetCurrencyRes struct{}
+
+type GetCurrenciesReq struct {
+	Enabled *bool
+}
+
+type GetCurrenciesRes struct {
+	Currencies []*Currency
+}
+
+func (impl *WalletImpl) SetCurrency(ctx context.Context, in *SetCurrencyReq) (*SetCurrencyRes, error) {
+
+	db := database.GetDB()
+	if in.Currency == nil || in.Currency.Code == "" || in.Currency.Name == "" ||
+		in.Currency.Decimals < 0 || in.Currency.Decimals > currency.MaxDecimals {
+		logging.Error(ctx, "[SetCurrency] invalid currency: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	model := &dbModels.CurrencyModel{
+		Code:           in.Currency.Code,
+		Name:           in.Currency.Name,
+		Decimals:       in.Currency.Decimals,
+		Enabled:        in.Currency.Enabled,
+		Provision:      in.Currency.Provision,
+		StartingAmount: decimal.Zero,
+	}
+	for _, l := range []struct {
+		value *string
+		field *decimal.NullDecimal
+	}{
+		{in.Currency.MinAmount, &model.MinAmount},
+		{in.Currency.MaxAmount, &model.MaxAmount},
+	} {
+		if l.value == nil {
+			continue
+		}
+		amount, err := decimal.NewFromString(*l.value)
+		if err != nil {
+			logging.Error(ctx, "[SetCurrency] failed to cast amount to decimal: %v", err)
+			return nil, err
+		}
+		if amount.IsNegative() || !currency.Fits(model.Decimals, amount) {
+			logging.Error(ctx, "[SetCurrency] invalid amount %s: %v", *l.value, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		*l.field = decimal.NewNullDecimal(amount)
+	}
+	if model.MinAmount.Valid && model.MaxAmount.Valid && model.MinAmount.Decimal.GreaterThan(model.MaxAmount.Decimal) {
+		logging.Error(ctx, "[SetCurrency] min amount over max amount: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if in.Currency.StartingAmount != nil {
+		amount, err := decimal.NewFromString(*in.Currency.StartingAmount)
+		if err != nil {
+			logging.Error(ctx, "[SetCurrency] failed to cast starting amount to decimal: %v", err)
+			return nil, err
+		}
+		if amount.IsNegative() || !currency.Fits(model.Decimals, amount) {
+			logging.Error(ctx, "[SetCurrency] invalid starting amount %s: %v", *in.Currency.StartingAmount, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		model.StartingAmount = amount
+	}
+
+	if _, err := currencyDao.NewOrReplace(db, model); err != nil {
+		logging.Error(ctx, "[SetCurrency] failed to set currency %s: %v", model.Code, err)
+		return nil, err
+	}
+
+	return &SetCurrencyRes{}, nil
+}
+
+func (impl *WalletImpl) GetCurrencies(ctx context.Context, in *GetCurrenciesReq) (*GetCurrenciesRes, error) {
+
+	db := database.GetDB()
+	models, err := currencyDao.Gets(db, &currencyDao.QueryModel{
+		Enabled: in.Enabled,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetCurrencies] failed to get currencies: %v", err)
+		return nil, err
+	}
+
+	res := &GetCurrenciesRes{}
+	for _, m := range models {
+		startingAmount := m.StartingAmount.String()
+		c := &Currency{
+			Code:           m.Code,
+			Name:           m.Name,
+			Decimals:       m.Decimals,
+			Enabled:        m.Enabled,
+			Provision:      m.Provision,
+			StartingAmount: &startingAmount,
+		}
+		if m.MinAmount.Valid {
+			minAmount := m.MinAmount.Decimal.String()
+			c.MinAmount = &minAmount
+		}
+		if m.MaxAmount.Valid {
+			maxAmount := m.MaxAmount.Decimal.String()
+			c.MaxAmount = &maxAmount
+		}
+		res.Currencies = append(res.Currencies, c)
+	}
+
+	return res, nil
+}
+
+// walletAmount checks that the request is in the currency of the wallet and
+// rounds amount to it.
+func walletAmount(ctx context.Context, db *gorm.DB, walletModel *dbModels.WalletModel, code string, amount decimal.Decimal) (decimal.Decimal, error) {
+	if code != walletModel.Currency {
+		logging.Error(ctx, "[walletAmount] wallet %d currency %s mismatch %s: %v", walletModel.ID, walletModel.Currency, code, models.ErrCurrencyMismatch)
+		return amount, models.ErrCurrencyMismatch
+	}
+
+	c, err := currency.Enabled(db, code)
+	if err != nil {
+		logging.Error(ctx, "[walletAmount] currency %s of wallet %d not usable: %v", code, walletModel.ID, err)
+		return amount, err
+	}
+
+	amount, err = currency.Amount(c, amount)
+	if err != nil {
+		logging.Error(ctx, "[walletAmount] amount %s of wallet %d not allowed: %v", amount.String(), walletModel.ID, err)
+		return amount, err
+	}
+	return amount, nil
+}
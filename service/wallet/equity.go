@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"context"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/currencyDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/service/rate"
+	"github.com/shopspring/decimal"
+)
+
+// GetMemberEquity values every wallet of the member in BaseCurrency. Equity is
+// the sum of the values, rounded to the decimals of BaseCurrency.
+func (impl *WalletImpl) GetMemberEquity(ctx context.Context, in *wallet.GetMemberEquityReq) (*wallet.GetMemberEquityRes, error) {
+
+	db := database.GetDB()
+	if in.BaseCurrency == "" {
+		logging.Error(ctx, "[GetMemberEquity] missing base currency: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	base, err := currencyDao.Get(db, &currencyDao.QueryModel{
+		Code: []string{in.BaseCurrency},
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetMemberEquity] failed to get currency %s: %v", in.BaseCurrency, err)
+		return nil, err
+	}
+	if base == nil {
+		logging.Error(ctx, "[GetMemberEquity] no such currency %s: %v", in.BaseCurrency, models.ErrNoSuchCurrency)
+		return nil, models.ErrNoSuchCurrency
+	}
+
+	walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{
+		MemberID: []uint64{in.MemberID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetMemberEquity] failed to get wallets of member %d: %v", in.MemberID, err)
+		return nil, err
+	}
+
+	res := &wallet.GetMemberEquityRes{
+		BaseCurrency: base.Code,
+	}
+	equity := decimal.Zero
+	for _, w := range walletModels {
+		walletRate, err := impl.RateProvider.Rate(ctx, w.Currency, base.Code)
+		if err != nil {
+			logging.Error(ctx, "[GetMemberEquity] failed to get rate from %s to %s: %v", w.Currency, base.Code, err)
+			return nil, err
+		}
+		walletRate = walletRate.Round(rate.Decimals)
+
+		value := w.Amount.Mul(walletRate)
+		equity = equity.Add(value)
+		res.Wallets = append(res.Wallets, &wallet.WalletEquity{
+			WalletID: w.ID,
+			Currency: w.Currency,
+			Amount:   w.Amount.String(),
+			Rate:     walletRate.String(),
+			Value:    value.Round(base.Decimals).String(),
+		})
+	}
+	res.Equity = equity.Round(base.Decimals).String()
+
+	return res, nil
+}
@@ -0,0 +1,293 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/outboxDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const (
+	// how long the reader of the event stream waits before checking that it
+	// still has subscribers
+	subscribeBlock = 5 * time.Second
+	// how long the reader waits after failing to read the event stream
+	subscribeRetry = time.Second
+	// how many events a subscriber may fall behind before it is dropped
+	subscriberBuffer = 256
+)
+
+// eventPayload is a change of a wallet, as written to the outbox, published to
+// the event stream and posted to webhooks. Amount is what the change added to
+// the wallet, negative for a debit or the rollback of a credit. A failed
+// transaction has no before and after amounts.
+type eventPayload struct {
+	Type          dbModels.WalletEventType `json:"type"`
+	TransactionID uint64                   `json:"transactionId,omitempty"`
+	MemberID      uint64                   `json:"memberId"`
+	WalletID      uint64                   `json:"walletId"`
+	Action        wallet.Action            `json:"action,omitempty"`
+	Amount        string                   `json:"amount,omitempty"`
+	BeforeAmount  string                   `json:"beforeAmount,omitempty"`
+	AfterAmount   string                   `json:"afterAmount,omitempty"`
+	Currency      string                   `json:"currency"`
+	WalletVersion uint64                   `json:"walletVersion,omitempty"`
+	OccurredAt    int64                    `json:"occurredAt"`
+}
+
+func (e *eventPayload) walletEvent() *wallet.WalletEvent {
+	return &wallet.WalletEvent{
+		Type:          wallet.WalletEventType(e.Type),
+		TransactionID: e.TransactionID,
+		MemberID:      e.MemberID,
+		WalletID:      e.WalletID,
+		Action:        e.Action,
+		Amount:        e.Amount,
+		BeforeAmount:  e.BeforeAmount,
+		AfterAmount:   e.AfterAmount,
+		Currency:      e.Currency,
+		WalletVersion: e.WalletVersion,
+		OccurredAt:    e.OccurredAt,
+	}
+}
+
+// SubscribeWalletEvents streams the balance changes of a member, of a wallet,
+// or of a wallet of the member if both are given, published from now on until
+// the client goes away. A client too slow to take the events is dropped rather
+// than let hold up the others.
+func (impl *WalletImpl) SubscribeWalletEvents(in *wallet.SubscribeWalletEventsReq, stream wallet.WalletService_SubscribeWalletEventsServer) error {
+
+	ctx := stream.Context()
+	if in.MemberID == nil && in.WalletID == nil {
+		logging.Error(ctx, "[SubscribeWalletEvents] missing member or wallet: %v", common.ErrInvalidParam)
+		return common.ErrInvalidParam
+	}
+
+	subscriber := &eventSubscriber{
+		memberID: in.MemberID,
+		walletID: in.WalletID,
+		events:   make(chan *eventPayload, subscriberBuffer),
+	}
+	walletEventHub.subscribe(subscriber)
+	defer walletEventHub.unsubscribe(subscriber)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscriber.events:
+			if !ok {
+				logging.Error(ctx, "[SubscribeWalletEvents] subscriber dropped: %v", models.ErrSubscriberTooSlow)
+				return models.ErrSubscriberTooSlow
+			}
+			if err := stream.Send(event.walletEvent()); err != nil {
+				logging.Error(ctx, "[SubscribeWalletEvents] failed to send wallet event: %v", err)
+				return err
+			}
+		}
+	}
+}
+
+// eventSubscriber is a SubscribeWalletEvents call waiting for the events of a
+// member, of a wallet, or of both.
+type eventSubscriber struct {
+	memberID *uint64
+	walletID *uint64
+	events   chan *eventPayload
+}
+
+func (s *eventSubscriber) wants(event *eventPayload) bool {
+	return (s.memberID == nil || event.MemberID == *s.memberID) &&
+		(s.walletID == nil || event.WalletID == *s.walletID)
+}
+
+// eventHub reads the event stream once for all the subscribers of the process,
+// and hands each subscriber the events it wants. It reads only while there
+// are subscribers.
+type eventHub struct {
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]struct{}
+	reading     bool
+}
+
+var walletEventHub = &eventHub{
+	subscribers: map[*eventSubscriber]struct{}{},
+}
+
+func (h *eventHub) subscribe(s *eventSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers[s] = struct{}{}
+	if !h.reading {
+		h.reading = true
+		go h.read()
+	}
+}
+
+func (h *eventHub) unsubscribe(s *eventSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.drop(s)
+}
+
+// drop removes the subscriber and closes its events, h.mu held.
+func (h *eventHub) drop(s *eventSubscriber) {
+	if _, ok := h.subscribers[s]; ok {
+		delete(h.subscribers, s)
+		close(s.events)
+	}
+}
+
+// publish hands the event to the subscribers wanting it, dropping those whose
+// events are full.
+func (h *eventHub) publish(event *eventPayload) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscribers {
+		if !s.wants(event) {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			h.drop(s)
+		}
+	}
+}
+
+// idle stops the reader if no one subscribes anymore.
+func (h *eventHub) idle() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subscribers) == 0 {
+		h.reading = false
+	}
+	return !h.reading
+}
+
+func (h *eventHub) read() {
+	ctx := context.Background()
+	r, _ := cache.GetRedis()
+
+	lastID := ""
+	for !h.idle() {
+		// start after the latest event so that none is missed between reads
+		if lastID == "" {
+			latest, err := r.XRevRangeN(ctx, WalletEventStreamKey, "+", "-", 1).Result()
+			if err != nil {
+				logging.Error(ctx, "[eventHub] failed to get latest wallet event: %v", err)
+				time.Sleep(subscribeRetry)
+				continue
+			}
+			lastID = "0-0"
+			if len(latest) > 0 {
+				lastID = latest[0].ID
+			}
+		}
+
+		streams, err := r.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{WalletEventStreamKey, lastID},
+			Count:   100,
+			Block:   subscribeBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			logging.Error(ctx, "[eventHub] failed to read wallet events: %v", err)
+			time.Sleep(subscribeRetry)
+			continue
+		}
+
+		for _, s := range streams {
+			for _, message := range s.Messages {
+				lastID = message.ID
+				payload, _ := message.Values["payload"].(string)
+				event := &eventPayload{}
+				if err := json.Unmarshal([]byte(payload), event); err != nil {
+					logging.Warn(ctx, "[eventHub] failed to unmarshal wallet event %s: %v", message.ID, err)
+					continue
+				}
+				if event.Type.BalanceChanged() {
+					h.publish(event)
+				}
+			}
+		}
+	}
+}
+
+// recordEvent is the event of a record changing the wallet from beforeAmount
+// to afterAmount.
+func recordEvent(eventType dbModels.WalletEventType, record *dbModels.TransactionRecordModel, beforeAmount decimal.Decimal, afterAmount decimal.Decimal, walletVersion uint64, at time.Time) *eventPayload {
+	return &eventPayload{
+		Type:          eventType,
+		TransactionID: record.ID,
+		MemberID:      record.MemberID,
+		WalletID:      record.WalletID,
+		Action:        wallet.Action(record.Action),
+		Amount:        afterAmount.Sub(beforeAmount).String(),
+		BeforeAmount:  beforeAmount.String(),
+		AfterAmount:   afterAmount.String(),
+		Currency:      record.Currency,
+		WalletVersion: walletVersion,
+		OccurredAt:    at.Unix(),
+	}
+}
+
+// failedEvent is the event of a record that never reached the wallet.
+func failedEvent(record *dbModels.TransactionRecordModel) *eventPayload {
+	return &eventPayload{
+		Type:          dbModels.WalletEventType_TransactionFailed,
+		TransactionID: record.ID,
+		MemberID:      record.MemberID,
+		WalletID:      record.WalletID,
+		Action:        wallet.Action(record.Action),
+		Amount:        record.Amount.String(),
+		Currency:      record.Currency,
+		OccurredAt:    time.Now().Unix(),
+	}
+}
+
+// walletEvent is the event of the wallet itself being created or deleted.
+func walletEvent(eventType dbModels.WalletEventType, walletModel *dbModels.WalletModel) *eventPayload {
+	return &eventPayload{
+		Type:          eventType,
+		MemberID:      walletModel.MemberID,
+		WalletID:      walletModel.ID,
+		AfterAmount:   walletModel.Amount.String(),
+		Currency:      walletModel.Currency,
+		WalletVersion: walletModel.Version,
+		OccurredAt:    time.Now().Unix(),
+	}
+}
+
+// writeOutbox writes the event in tx, the transaction of the change, for
+// RelayOutbox to publish once it commits.
+func writeOutbox(ctx context.Context, tx *gorm.DB, event *eventPayload) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logging.Error(ctx, "[writeOutbox] failed to marshal event of wallet %d: %v", event.WalletID, err)
+		return err
+	}
+	if _, err := outboxDao.New(tx, &dbModels.OutboxModel{
+		WalletID:  event.WalletID,
+		EventType: event.Type,
+		Payload:   string(payload),
+	}); err != nil {
+		logging.Error(ctx, "[writeOutbox] failed to new outbox event of wallet %d: %v", event.WalletID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/currency"
+	"github.com/paper-trade-chatbot/be-wallet/service/rate"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Exchange debits the wallet of FromCurrency and credits the wallet of
+// ToCurrency at the rate quoted by the rate provider. The credit is truncated
+// to the decimals of ToCurrency. Both legs share a transfer id, so rolling
+// back either one rolls back the exchange.
+func (impl *WalletImpl) Exchange(ctx context.Context, in *wallet.ExchangeReq) (*wallet.ExchangeRes, error) {
+
+	db := database.GetDB()
+	amount, err := decimal.NewFromString(in.Amount)
+	if err != nil {
+		logging.Error(ctx, "[Exchange] failed to cast amount to decimal: %v", err)
+		return nil, err
+	}
+	if !amount.IsPositive() || in.FromCurrency == in.ToCurrency {
+		logging.Error(ctx, "[Exchange] invalid exchange %s from %s to %s: %v", in.Amount, in.FromCurrency, in.ToCurrency, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	from, err := memberWallet(ctx, db, in.MemberID, in.FromCurrency)
+	if err != nil {
+		return nil, err
+	}
+	to, err := memberWallet(ctx, db, in.MemberID, in.ToCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	fromAmount, err := walletAmount(ctx, db, from, in.FromCurrency, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate, err := impl.RateProvider.Rate(ctx, in.FromCurrency, in.ToCurrency)
+	if err != nil {
+		logging.Error(ctx, "[Exchange] failed to get rate from %s to %s: %v", in.FromCurrency, in.ToCurrency, err)
+		return nil, err
+	}
+	exchangeRate = exchangeRate.Round(rate.Decimals)
+	if !exchangeRate.IsPositive() {
+		logging.Error(ctx, "[Exchange] invalid rate %s from %s to %s: %v", exchangeRate.String(), in.FromCurrency, in.ToCurrency, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	toCurrency, err := currency.Enabled(db, in.ToCurrency)
+	if err != nil {
+		logging.Error(ctx, "[Exchange] currency %s not usable: %v", in.ToCurrency, err)
+		return nil, err
+	}
+	toAmount, err := walletAmount(ctx, db, to, in.ToCurrency, fromAmount.Mul(exchangeRate).Truncate(toCurrency.Decimals))
+	if err != nil {
+		return nil, err
+	}
+	if !fromAmount.IsPositive() || !toAmount.IsPositive() {
+		logging.Error(ctx, "[Exchange] amount %s rounds to nothing: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	exchangeID, _ := uuid.NewV4()
+	records := make([]*dbModels.TransactionRecordModel, 0, 2)
+	for _, leg := range []struct {
+		walletModel *dbModels.WalletModel
+		amount      decimal.Decimal
+	}{
+		{from, fromAmount.Neg()},
+		{to, toAmount},
+	} {
+		record := &dbModels.TransactionRecordModel{
+			MemberID:     in.MemberID,
+			WalletID:     leg.walletModel.ID,
+			Action:       dbModels.TransactionAction_Exchange,
+			Amount:       leg.amount,
+			Currency:     leg.walletModel.Currency,
+			CommitterID:  in.CommitterID,
+			Status:       dbModels.TransactionStatus_Pending,
+			ExchangeRate: decimal.NewNullDecimal(exchangeRate),
+			TransferID: sql.NullString{
+				Valid:  true,
+				String: exchangeID.String(),
+			},
+		}
+		if in.Remark != nil {
+			record.Remark = sql.NullString{
+				Valid:  true,
+				String: *in.Remark,
+			}
+		}
+		records = append(records, record)
+	}
+
+	if _, err := transactionRecordDao.News(db, records); err != nil {
+		logging.Error(ctx, "[Exchange] failed to new transaction records: %v", err)
+		return nil, err
+	}
+
+	// lock wallets in id order so that opposite exchanges can not deadlock
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].WalletID < records[j].WalletID
+	})
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return applyRecords(ctx, tx, records, nil)
+	})
+	if err != nil {
+		logging.Error(ctx, "[Exchange] failed to apply exchange %s: %v", exchangeID.String(), err)
+		failRecords(ctx, db, records)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, from.ID, to.ID)
+
+	res := &wallet.ExchangeRes{
+		ExchangeID: exchangeID.String(),
+		Rate:       exchangeRate.String(),
+	}
+	for _, record := range records {
+		record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			ID: &record.ID,
+		})
+		if err != nil {
+			logging.Error(ctx, "[Exchange] failed to get modified transaction record: %v", err)
+			return nil, err
+		}
+
+		if record.WalletID == from.ID {
+			res.From = transactionRes(record)
+		} else {
+			res.To = transactionRes(record)
+		}
+	}
+
+	return res, nil
+}
+
+// memberWallet returns the wallet of the member in the currency.
+func memberWallet(ctx context.Context, db *gorm.DB, memberID uint64, code string) (*dbModels.WalletModel, error) {
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		MemberID: []uint64{memberID},
+		Currency: &code,
+	})
+	if err != nil {
+		logging.Error(ctx, "[memberWallet] failed to get %s wallet of member %d: %v", code, memberID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		logging.Error(ctx, "[memberWallet] no %s wallet of member %d: %v", code, memberID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	return walletModel, nil
+}
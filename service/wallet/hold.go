@@ -0,0 +1,344 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletHoldDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+type GetWalletBalanceReq struct {
+	WalletID uint64
+}
+
+// GetWalletBalanceRes splits the wallet amount into what is held and what
+// is still available.
+type GetWalletBalanceRes struct {
+	WalletID        uint64
+	Currency        string
+	Status          dbModels.WalletStatus
+	TotalAmount     string
+	HeldAmount      string
+	AvailableAmount string
+	CreditLimit     string
+	// since when the total amount is negative
+	NegativeSince *int64
+}
+
+type PlaceHoldReq struct {
+	WalletID    uint64
+	Amount      string
+	Currency    string
+	CommitterID uint64
+	Remark      *string
+}
+
+type PlaceHoldRes struct {
+	HoldID uint64
+}
+
+// CaptureHoldReq settles a hold. Amount defaults to the whole hold, a smaller
+// amount is debited and the rest of the hold is released.
+type CaptureHoldReq struct {
+	HoldID      uint64
+	Amount      *string
+	Action      wallet.Action
+	CommitterID uint64
+	Remark      *string
+}
+
+type ReleaseHoldReq struct {
+	HoldID uint64
+}
+
+type ReleaseHoldRes struct{}
+
+func (impl *WalletImpl) GetWalletBalance(ctx context.Context, in *GetWalletBalanceReq) (*GetWalletBalanceRes, error) {
+
+	db := database.GetDB()
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{in.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetWalletBalance] failed to get wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		return nil, common.ErrNoSuchWallet
+	}
+
+	res := &GetWalletBalanceRes{
+		WalletID:        walletModel.ID,
+		Currency:        walletModel.Currency,
+		Status:          walletModel.Status,
+		TotalAmount:     walletModel.Amount.String(),
+		HeldAmount:      walletModel.HeldAmount.String(),
+		AvailableAmount: walletModel.AvailableAmount().String(),
+		CreditLimit:     walletModel.CreditLimit.String(),
+	}
+	if walletModel.NegativeSince.Valid {
+		negativeSince := walletModel.NegativeSince.Time.Unix()
+		res.NegativeSince = &negativeSince
+	}
+
+	return res, nil
+}
+
+func (impl *WalletImpl) PlaceHold(ctx context.Context, in *PlaceHoldReq) (*PlaceHoldRes, error) {
+
+	db := database.GetDB()
+	amount, err := decimal.NewFromString(in.Amount)
+	if err != nil {
+		logging.Error(ctx, "[PlaceHold] failed to cast amount to decimal: %v", err)
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		logging.Error(ctx, "[PlaceHold] invalid hold amount %s: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{in.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[PlaceHold] failed to get wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		logging.Error(ctx, "[PlaceHold] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	amount, err = walletAmount(ctx, db, walletModel, in.Currency, amount)
+	if err != nil {
+		return nil, err
+	}
+	if !amount.IsPositive() {
+		logging.Error(ctx, "[PlaceHold] invalid hold amount %s: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	hold := &dbModels.WalletHoldModel{
+		MemberID:    walletModel.MemberID,
+		WalletID:    walletModel.ID,
+		Amount:      amount,
+		Currency:    walletModel.Currency,
+		Status:      dbModels.WalletHoldStatus_Held,
+		CommitterID: in.CommitterID,
+	}
+	if in.Remark != nil {
+		hold.Remark = sql.NullString{
+			Valid:  true,
+			String: *in.Remark,
+		}
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := placeHold(ctx, tx, hold); err != nil {
+			return err
+		}
+		if _, err := walletHoldDao.New(tx, hold); err != nil {
+			logging.Error(ctx, "[PlaceHold] failed to new hold: %v", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, hold.WalletID)
+
+	return &PlaceHoldRes{
+		HoldID: hold.ID,
+	}, nil
+}
+
+func (impl *WalletImpl) CaptureHold(ctx context.Context, in *CaptureHoldReq) (*wallet.TransactionRes, error) {
+
+	db := database.GetDB()
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+	hold, err := activeHold(ctx, db, in.HoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := hold.Amount
+	if in.Amount != nil {
+		amount, err = decimal.NewFromString(*in.Amount)
+		if err != nil {
+			logging.Error(ctx, "[CaptureHold] failed to cast amount to decimal: %v", err)
+			return nil, err
+		}
+		if !amount.IsPositive() || amount.GreaterThan(hold.Amount) {
+			logging.Error(ctx, "[CaptureHold] invalid capture amount %s of hold %d: %v", *in.Amount, hold.ID, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+	}
+
+	action := dbModels.TransactionAction(in.Action)
+	if action == dbModels.TransactionAction_NONE {
+		action = dbModels.TransactionAction_Open
+	}
+
+	transactionRecord := &dbModels.TransactionRecordModel{
+		MemberID:    hold.MemberID,
+		WalletID:    hold.WalletID,
+		Action:      action,
+		Amount:      amount.Neg(),
+		Currency:    hold.Currency,
+		CommitterID: in.CommitterID,
+		Status:      dbModels.TransactionStatus_Pending,
+	}
+	if in.Remark != nil {
+		transactionRecord.Remark = sql.NullString{
+			Valid:  true,
+			String: *in.Remark,
+		}
+	}
+
+	if _, err := transactionRecordDao.New(db, transactionRecord); err != nil {
+		logging.Error(ctx, "[CaptureHold] failed to new transaction record: %v", err)
+		return nil, err
+	}
+
+	records := []*dbModels.TransactionRecordModel{transactionRecord}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		status := dbModels.WalletHoldStatus_Captured
+		if err := walletHoldDao.Modify(tx, hold, &walletHoldDao.UpdateModel{
+			Status: &status,
+			TransactionRecordID: &sql.NullInt64{
+				Valid: true,
+				Int64: int64(transactionRecord.ID),
+			},
+		}); err != nil {
+			logging.Error(ctx, "[CaptureHold] failed to modify hold %d: %v", hold.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return models.ErrHoldNotActive
+			}
+			return err
+		}
+
+		return applyRecords(ctx, tx, records, func(_ *dbModels.TransactionRecordModel, _ *dbModels.WalletModel, update *walletDao.UpdateModel) error {
+			heldAmount := update.HeldAmount.Sub(hold.Amount)
+			update.HeldAmount = &heldAmount
+			return nil
+		})
+	})
+	if err != nil {
+		logging.Error(ctx, "[CaptureHold] failed to capture hold %d: %v", hold.ID, err)
+		failRecords(ctx, db, records)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, transactionRecord.WalletID)
+
+	transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		ID: &transactionRecord.ID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[CaptureHold] failed to get modified transaction record: %v", err)
+		return nil, err
+	}
+
+	return transactionRes(transactionRecord), nil
+}
+
+func (impl *WalletImpl) ReleaseHold(ctx context.Context, in *ReleaseHoldReq) (*ReleaseHoldRes, error) {
+
+	db := database.GetDB()
+	hold, err := activeHold(ctx, db, in.HoldID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return releaseHold(ctx, tx, hold)
+	})
+	if err != nil {
+		logging.Error(ctx, "[ReleaseHold] failed to release hold %d: %v", hold.ID, err)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, hold.WalletID)
+
+	return &ReleaseHoldRes{}, nil
+}
+
+func activeHold(ctx context.Context, db *gorm.DB, holdID uint64) (*dbModels.WalletHoldModel, error) {
+	hold, err := walletHoldDao.Get(db, &walletHoldDao.QueryModel{
+		ID: &holdID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[activeHold] failed to get hold %d: %v", holdID, err)
+		return nil, err
+	}
+	if hold == nil {
+		logging.Error(ctx, "[activeHold] no such hold %d: %v", holdID, models.ErrNoSuchHold)
+		return nil, models.ErrNoSuchHold
+	}
+	// holds of prepared transactions are settled by confirming or cancelling them
+	if hold.Status != dbModels.WalletHoldStatus_Held || hold.TransactionRecordID.Valid {
+		logging.Error(ctx, "[activeHold] hold %d is not held: %v", holdID, models.ErrHoldNotActive)
+		return nil, models.ErrHoldNotActive
+	}
+	return hold, nil
+}
+
+// placeHold reserves the hold amount of the wallet, which must be spendable.
+func placeHold(ctx context.Context, tx *gorm.DB, hold *dbModels.WalletHoldModel) error {
+	if _, err := updateWallet(ctx, tx, hold.WalletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+		// a hold is a debit to come
+		if err := checkWalletStatus(walletModel, hold.Amount.Neg()); err != nil {
+			return nil, err
+		}
+		if walletModel.SpendableAmount().LessThan(hold.Amount) {
+			return nil, common.ErrInsufficientBalance
+		}
+		heldAmount := walletModel.HeldAmount.Add(hold.Amount)
+		return &walletDao.UpdateModel{
+			HeldAmount: &heldAmount,
+		}, nil
+	}); err != nil {
+		logging.Error(ctx, "[placeHold] failed to hold %s on wallet %d: %v", hold.Amount.String(), hold.WalletID, err)
+		return err
+	}
+	return nil
+}
+
+// releaseHold marks the hold released and returns its amount to available.
+func releaseHold(ctx context.Context, tx *gorm.DB, hold *dbModels.WalletHoldModel) error {
+	status := dbModels.WalletHoldStatus_Released
+	if err := walletHoldDao.Modify(tx, hold, &walletHoldDao.UpdateModel{
+		Status: &status,
+	}); err != nil {
+		logging.Error(ctx, "[releaseHold] failed to modify hold %d: %v", hold.ID, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrHoldNotActive
+		}
+		return err
+	}
+
+	if _, err := updateWallet(ctx, tx, hold.WalletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+		heldAmount := walletModel.HeldAmount.Sub(hold.Amount)
+		return &walletDao.UpdateModel{
+			HeldAmount: &heldAmount,
+		}, nil
+	}); err != nil {
+		logging.Error(ctx, "[releaseHold] failed to release %s on wallet %d: %v", hold.Amount.String(), hold.WalletID, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"context"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/currencyDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const maxIdempotencyKeyLength = 64
+
+// idempotencyKey validates the client supplied idempotency key of a request,
+// empty if it has none. A retried request with the same key returns the
+// original result instead of moving money again, and a different request with
+// the key is refused.
+func idempotencyKey(key *string) (string, error) {
+	if key == nil {
+		return "", nil
+	}
+	if len(*key) > maxIdempotencyKeyLength {
+		return "", common.ErrInvalidParam
+	}
+	return *key, nil
+}
+
+// checkReplay tells if the request replaying the idempotency key of the record
+// is the one that made the record. The amount is compared as rounded to the
+// currency. A wallet belongs to one member for good, so the same wallet means
+// the same member. A prepared record only replays PrepareTransaction, and a
+// plain one Transaction.
+func checkReplay(ctx context.Context, db *gorm.DB, key string, record *dbModels.TransactionRecordModel, prepared bool, walletID uint64, action wallet.Action, code string, amount decimal.Decimal) error {
+	c, err := currencyDao.Get(db, &currencyDao.QueryModel{
+		Code: []string{record.Currency},
+	})
+	if err != nil {
+		logging.Error(ctx, "[checkReplay] failed to get currency %s: %v", record.Currency, err)
+		return err
+	}
+	if c != nil {
+		amount = amount.Round(c.Decimals)
+	}
+
+	if record.PreparedUntil.Valid != prepared || record.WalletID != walletID ||
+		record.Action != dbModels.TransactionAction(action) || record.Currency != code || !record.Amount.Equal(amount) {
+		logging.Error(ctx, "[checkReplay] idempotency key %s of transaction %d replayed by another request: %v", key, record.ID, models.ErrIdempotencyKeyConflict)
+		return models.ErrIdempotencyKeyConflict
+	}
+	return nil
+}
+
+func transactionRes(record *dbModels.TransactionRecordModel) *wallet.TransactionRes {
+	return &wallet.TransactionRes{
+		Id:           record.ID,
+		BeforeAmount: record.BeforeAmount.Decimal.String(),
+		AfterAmount:  record.AfterAmount.Decimal.String(),
+		Currency:     record.Currency,
+		Status:       wallet.Status(record.Status),
+		CreatedAt:    record.CreatedAt.Unix(),
+		UpdatedAt:    record.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,356 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/interestAccrualDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/interestRateDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/currency"
+	"github.com/paper-trade-chatbot/be-wallet/service/interest"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// InterestRateTier is the annual rate earned by the part of a balance from
+// MinBalance up to the MinBalance of the next tier.
+type InterestRateTier struct {
+	MinBalance string
+	AnnualRate string
+}
+
+// SetInterestRatesReq replaces the tiers of a currency, no tiers stops the
+// currency earning interest.
+type SetInterestRatesReq struct {
+	Currency string
+	Tiers    []*InterestRateTier
+}
+
+type SetInterestRatesRes struct{}
+
+type GetInterestRatesReq struct {
+	Currency *string
+}
+
+type CurrencyInterestRates struct {
+	Currency string
+	Tiers    []*InterestRateTier
+}
+
+type GetInterestRatesRes struct {
+	Rates []*CurrencyInterestRates
+}
+
+// AccrueInterestReq accrues one day of interest on the balances at the end of
+// the day. Accruing a date again does nothing.
+type AccrueInterestReq struct {
+	// unix time of the day in UTC
+	Date int64
+}
+
+type AccrueInterestRes struct {
+	Accrued int
+}
+
+// CapitalizeInterestReq credits the interest accrued before Before, one
+// Interest transaction per wallet and month. Interest below the decimal places
+// of the currency is carried to the next month.
+type CapitalizeInterestReq struct {
+	Before int64
+}
+
+type CapitalizeInterestRes struct {
+	TransactionRecordIDs []uint64
+}
+
+func (impl *WalletImpl) SetInterestRates(ctx context.Context, in *SetInterestRatesReq) (*SetInterestRatesRes, error) {
+
+	db := database.GetDB()
+	if in.Currency == "" {
+		logging.Error(ctx, "[SetInterestRates] empty currency: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	rates := make([]*dbModels.InterestRateModel, 0, len(in.Tiers))
+	for _, t := range in.Tiers {
+		minBalance, err := decimal.NewFromString(t.MinBalance)
+		if err != nil {
+			logging.Error(ctx, "[SetInterestRates] failed to cast min balance to decimal: %v", err)
+			return nil, err
+		}
+		annualRate, err := decimal.NewFromString(t.AnnualRate)
+		if err != nil {
+			logging.Error(ctx, "[SetInterestRates] failed to cast annual rate to decimal: %v", err)
+			return nil, err
+		}
+		if minBalance.IsNegative() || annualRate.IsNegative() {
+			logging.Error(ctx, "[SetInterestRates] invalid tier %s at %s: %v", t.AnnualRate, t.MinBalance, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		rates = append(rates, &dbModels.InterestRateModel{
+			Currency:   in.Currency,
+			MinBalance: minBalance,
+			AnnualRate: annualRate,
+		})
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := interestRateDao.Delete(tx, &interestRateDao.QueryModel{
+			Currency: []string{in.Currency},
+		}); err != nil {
+			return err
+		}
+		if len(rates) == 0 {
+			return nil
+		}
+		_, err := interestRateDao.News(tx, rates)
+		return err
+	})
+	if err != nil {
+		logging.Error(ctx, "[SetInterestRates] failed to set interest rates of %s: %v", in.Currency, err)
+		return nil, err
+	}
+
+	return &SetInterestRatesRes{}, nil
+}
+
+func (impl *WalletImpl) GetInterestRates(ctx context.Context, in *GetInterestRatesReq) (*GetInterestRatesRes, error) {
+
+	db := database.GetDB()
+	query := &interestRateDao.QueryModel{}
+	if in.Currency != nil {
+		query.Currency = []string{*in.Currency}
+	}
+
+	rates, err := interestRateDao.Gets(db, query)
+	if err != nil {
+		logging.Error(ctx, "[GetInterestRates] failed to get interest rates: %v", err)
+		return nil, err
+	}
+
+	res := &GetInterestRatesRes{}
+	for currency, tiers := range ratesByCurrency(rates) {
+		currencyRates := &CurrencyInterestRates{
+			Currency: currency,
+		}
+		for _, t := range tiers {
+			currencyRates.Tiers = append(currencyRates.Tiers, &InterestRateTier{
+				MinBalance: t.MinBalance.String(),
+				AnnualRate: t.AnnualRate.String(),
+			})
+		}
+		res.Rates = append(res.Rates, currencyRates)
+	}
+	sort.Slice(res.Rates, func(i, j int) bool {
+		return res.Rates[i].Currency < res.Rates[j].Currency
+	})
+
+	return res, nil
+}
+
+func (impl *WalletImpl) AccrueInterest(ctx context.Context, in *AccrueInterestReq) (*AccrueInterestRes, error) {
+
+	db := database.GetDB()
+	date := time.Unix(in.Date, 0).UTC().Truncate(24 * time.Hour)
+	endOfDay := date.Add(24*time.Hour - time.Second)
+
+	rates, err := interestRateDao.Gets(db, &interestRateDao.QueryModel{})
+	if err != nil {
+		logging.Error(ctx, "[AccrueInterest] failed to get interest rates: %v", err)
+		return nil, err
+	}
+
+	res := &AccrueInterestRes{}
+	for currency, tiers := range ratesByCurrency(rates) {
+		currency := currency
+		walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{
+			Currency: &currency,
+		})
+		if err != nil {
+			logging.Error(ctx, "[AccrueInterest] failed to get wallets of %s: %v", currency, err)
+			return res, err
+		}
+
+		accruals := []*dbModels.InterestAccrualModel{}
+		for _, w := range walletModels {
+			balance, err := balanceAt(db, w.ID, endOfDay)
+			if err != nil {
+				logging.Error(ctx, "[AccrueInterest] failed to get balance of wallet %d: %v", w.ID, err)
+				return res, err
+			}
+			amount := interest.Daily(tiers, balance)
+			if !amount.IsPositive() {
+				continue
+			}
+			accruals = append(accruals, &dbModels.InterestAccrualModel{
+				MemberID:    w.MemberID,
+				WalletID:    w.ID,
+				AccrualDate: date,
+				Balance:     balance,
+				Amount:      amount,
+				Currency:    w.Currency,
+				Status:      dbModels.InterestAccrualStatus_Accrued,
+			})
+		}
+		if len(accruals) == 0 {
+			continue
+		}
+
+		accrued, err := interestAccrualDao.NewsIfNotExist(db, accruals)
+		if err != nil {
+			logging.Error(ctx, "[AccrueInterest] failed to new accruals of %s: %v", currency, err)
+			return res, err
+		}
+		res.Accrued += accrued
+	}
+
+	logging.Info(ctx, "[AccrueInterest] accrued %d wallets on %s", res.Accrued, date.Format("2006-01-02"))
+	return res, nil
+}
+
+// CapitalizeInterest posts the accrued interest through Transaction. The
+// idempotency key of each wallet and month keeps a rerun after a crash from
+// crediting it twice.
+func (impl *WalletImpl) CapitalizeInterest(ctx context.Context, in *CapitalizeInterestReq) (*CapitalizeInterestRes, error) {
+
+	db := database.GetDB()
+	before := time.Unix(in.Before, 0).UTC()
+
+	accruals, err := interestAccrualDao.Gets(db, &interestAccrualDao.QueryModel{
+		Status:            []dbModels.InterestAccrualStatus{dbModels.InterestAccrualStatus_Accrued},
+		AccrualDateBefore: &before,
+	})
+	if err != nil {
+		logging.Error(ctx, "[CapitalizeInterest] failed to get accruals: %v", err)
+		return nil, err
+	}
+
+	// accruals are ordered by wallet and date, so each group is contiguous
+	res := &CapitalizeInterestRes{}
+	failed := map[uint64]bool{}
+	for start := 0; start < len(accruals); {
+		end := start + 1
+		month := accruals[start].AccrualDate.Format("2006-01")
+		for end < len(accruals) &&
+			accruals[end].WalletID == accruals[start].WalletID &&
+			accruals[end].AccrualDate.Format("2006-01") == month {
+			end++
+		}
+
+		// a later month would take the remainder the failed one carries on
+		if failed[accruals[start].WalletID] {
+			start = end
+			continue
+		}
+		record, err := impl.capitalize(ctx, db, month, accruals[start:end])
+		if err != nil {
+			logging.Error(ctx, "[CapitalizeInterest] failed to capitalize interest of wallet %d in %s: %v", accruals[start].WalletID, month, err)
+			failed[accruals[start].WalletID] = true
+		} else if record != nil {
+			res.TransactionRecordIDs = append(res.TransactionRecordIDs, record.Id)
+		}
+		start = end
+	}
+
+	logging.Info(ctx, "[CapitalizeInterest] posted transactions %v", res.TransactionRecordIDs)
+	return res, nil
+}
+
+func (impl *WalletImpl) capitalize(ctx context.Context, db *gorm.DB, month string, accruals []dbModels.InterestAccrualModel) (*wallet.TransactionRes, error) {
+
+	walletID := accruals[0].WalletID
+
+	// the month starts from what the last capitalized month left uncredited
+	capitalized := dbModels.InterestAccrualStatus_Capitalized
+	previous, err := interestAccrualDao.GetLatest(db, &interestAccrualDao.QueryModel{
+		WalletID:          &walletID,
+		Status:            []dbModels.InterestAccrualStatus{capitalized},
+		AccrualDateBefore: &accruals[0].AccrualDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	total := decimal.Zero
+	if previous != nil {
+		total = previous.Remainder
+	}
+	for _, a := range accruals {
+		total = total.Add(a.Amount)
+	}
+
+	c, err := currency.Enabled(db, accruals[0].Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// interest below the decimal places of the currency is left to the last
+	// accrual of the month, for the next month to carry on
+	var res *wallet.TransactionRes
+	update := &interestAccrualDao.UpdateModel{}
+	amount := total.Truncate(c.Decimals)
+	remainder := total.Sub(amount)
+	if amount.IsPositive() {
+		remark := "interest " + month
+		key := fmt.Sprintf("interest:%d:%s", walletID, month)
+		res, err = impl.Transaction(systemContext(ctx), &wallet.TransactionReq{
+			WalletID:       walletID,
+			Action:         wallet.Action(dbModels.TransactionAction_Interest),
+			Amount:         amount.String(),
+			Currency:       accruals[0].Currency,
+			Remark:         &remark,
+			IdempotencyKey: &key,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res.Status != wallet.Status(dbModels.TransactionStatus_Success) {
+			return nil, common.ErrTransactionNotSuccess
+		}
+		update.TransactionRecordID = &sql.NullInt64{
+			Valid: true,
+			Int64: int64(res.Id),
+		}
+	}
+
+	update.Status = &capitalized
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for i := range accruals {
+			if i == len(accruals)-1 {
+				update.Remainder = &remainder
+			}
+			err := interestAccrualDao.Modify(tx, &accruals[i], update)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// capitalized by a concurrent run
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ratesByCurrency groups rates ordered by currency and tier.
+func ratesByCurrency(rates []dbModels.InterestRateModel) map[string][]dbModels.InterestRateModel {
+	grouped := map[string][]dbModels.InterestRateModel{}
+	for _, r := range rates {
+		grouped[r.Currency] = append(grouped[r.Currency], r)
+	}
+	return grouped
+}
@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/gofrs/uuid"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/logging"
+)
+
+// compareAndExpire sets KEYS[1] to expire in ARGV[2] milliseconds if it still
+// holds ARGV[1].
+var compareAndExpire = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// compareAndDelete deletes KEYS[1] if it still holds ARGV[1].
+var compareAndDelete = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// jobLock lets one process at a time run a job. It expires unless extended,
+// so that a crashed holder does not stop the job for good.
+type jobLock struct {
+	key    string
+	holder string
+	expiry time.Duration
+}
+
+// lockJob takes the lock of the key, nil if another process holds it.
+func lockJob(ctx context.Context, key string, expiry time.Duration) (*jobLock, error) {
+	holder, _ := uuid.NewV4()
+	l := &jobLock{
+		key:    key,
+		holder: holder.String(),
+		expiry: expiry,
+	}
+
+	r, _ := cache.GetRedis()
+	locked, err := r.SetNX(ctx, l.key, l.holder, l.expiry).Result()
+	if err != nil || !locked {
+		return nil, err
+	}
+	return l, nil
+}
+
+// extend restarts the expiry of the lock, telling if it is still held.
+func (l *jobLock) extend(ctx context.Context) (bool, error) {
+	r, _ := cache.GetRedis()
+	extended, err := compareAndExpire.Run(ctx, r, []string{l.key}, l.holder, l.expiry.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return extended == 1, nil
+}
+
+// unlock releases the lock unless it expired and another process took it.
+func (l *jobLock) unlock(ctx context.Context) {
+	// ctx may be done already, the job having run out of time
+	r, _ := cache.GetRedis()
+	if err := compareAndDelete.Run(context.Background(), r, []string{l.key}, l.holder).Err(); err != nil {
+		logging.Error(ctx, "[jobLock] failed to unlock %s: %v", l.key, err)
+	}
+}
@@ -0,0 +1,80 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/journalLineDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+)
+
+type GetTrialBalanceReq struct {
+	Currency string
+}
+
+type LedgerAccountBalance struct {
+	AccountID uint64
+	Code      string
+	Debit     string
+	Credit    string
+	// Balance is debit minus credit, what the account has paid out
+	Balance string
+}
+
+// GetTrialBalanceRes proves the money supply of a currency: TotalSupply, the
+// sum of all wallet accounts, equals the sum of the system account balances.
+type GetTrialBalanceRes struct {
+	SystemAccounts []*LedgerAccountBalance
+	TotalSupply    string
+	TotalDebit     string
+	TotalCredit    string
+	Balanced       bool
+}
+
+func (impl *WalletImpl) GetTrialBalance(ctx context.Context, in *GetTrialBalanceReq) (*GetTrialBalanceRes, error) {
+
+	db := database.GetDB()
+
+	systemType := dbModels.LedgerAccountType_System
+	sums, err := journalLineDao.SumByAccount(db, &journalLineDao.QueryModel{
+		AccountType: &systemType,
+		Currency:    &in.Currency,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetTrialBalance] failed to sum system accounts: %v", err)
+		return nil, err
+	}
+
+	walletType := dbModels.LedgerAccountType_Wallet
+	walletSum, err := journalLineDao.Sum(db, &journalLineDao.QueryModel{
+		AccountType: &walletType,
+		Currency:    &in.Currency,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetTrialBalance] failed to sum wallet accounts: %v", err)
+		return nil, err
+	}
+
+	res := &GetTrialBalanceRes{
+		TotalSupply: walletSum.Credit.Sub(walletSum.Debit).String(),
+	}
+	totalDebit := walletSum.Debit
+	totalCredit := walletSum.Credit
+	for _, s := range sums {
+		res.SystemAccounts = append(res.SystemAccounts, &LedgerAccountBalance{
+			AccountID: s.AccountID,
+			Code:      s.Code,
+			Debit:     s.Debit.String(),
+			Credit:    s.Credit.String(),
+			Balance:   s.Debit.Sub(s.Credit).String(),
+		})
+		totalDebit = totalDebit.Add(s.Debit)
+		totalCredit = totalCredit.Add(s.Credit)
+	}
+	res.TotalDebit = totalDebit.String()
+	res.TotalCredit = totalCredit.String()
+	res.Balanced = totalDebit.Equal(totalCredit)
+
+	return res, nil
+}
@@ -0,0 +1,260 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionLimitDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// TransactionLimit limits the transactions of a currency. Action and WalletID
+// of zero apply to every action and every wallet, the limit of a wallet
+// overrides the one of every wallet. A nil limit is unlimited. Amounts are
+// counted both ways, debits and credits alike.
+type TransactionLimit struct {
+	Id            uint64
+	Currency      string
+	Action        wallet.Action
+	WalletID      uint64
+	MaxAmount     *string
+	DailyAmount   *string
+	MonthlyAmount *string
+	DailyCount    *int64
+	MonthlyCount  *int64
+}
+
+// SetTransactionLimitReq replaces the limit of the same currency, action and
+// wallet, Id is ignored.
+type SetTransactionLimitReq struct {
+	Limit *TransactionLimit
+}
+
+type SetTransactionLimitRes struct{}
+
+type DeleteTransactionLimitReq struct {
+	Id uint64
+}
+
+type DeleteTransactionLimitRes struct{}
+
+type GetTransactionLimitsReq struct {
+	Currency *string
+	WalletID *uint64
+}
+
+type GetTransactionLimitsRes struct {
+	Limits []*TransactionLimit
+}
+
+func (impl *WalletImpl) SetTransactionLimit(ctx context.Context, in *SetTransactionLimitReq) (*SetTransactionLimitRes, error) {
+
+	db := database.GetDB()
+	if in.Limit == nil || in.Limit.Currency == "" {
+		logging.Error(ctx, "[SetTransactionLimit] empty limit: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	model := &dbModels.TransactionLimitModel{
+		Currency: in.Limit.Currency,
+		Action:   dbModels.TransactionAction(in.Limit.Action),
+		WalletID: in.Limit.WalletID,
+	}
+	for _, l := range []struct {
+		value *string
+		field *decimal.NullDecimal
+	}{
+		{in.Limit.MaxAmount, &model.MaxAmount},
+		{in.Limit.DailyAmount, &model.DailyAmount},
+		{in.Limit.MonthlyAmount, &model.MonthlyAmount},
+	} {
+		if l.value == nil {
+			continue
+		}
+		amount, err := decimal.NewFromString(*l.value)
+		if err != nil {
+			logging.Error(ctx, "[SetTransactionLimit] failed to cast limit to decimal: %v", err)
+			return nil, err
+		}
+		if amount.IsNegative() {
+			logging.Error(ctx, "[SetTransactionLimit] negative limit %s: %v", *l.value, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		*l.field = decimal.NewNullDecimal(amount)
+	}
+	for _, l := range []struct {
+		value *int64
+		field *sql.NullInt64
+	}{
+		{in.Limit.DailyCount, &model.DailyCount},
+		{in.Limit.MonthlyCount, &model.MonthlyCount},
+	} {
+		if l.value == nil {
+			continue
+		}
+		if *l.value < 0 {
+			logging.Error(ctx, "[SetTransactionLimit] negative limit %d: %v", *l.value, common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		*l.field = sql.NullInt64{
+			Valid: true,
+			Int64: *l.value,
+		}
+	}
+
+	if _, err := transactionLimitDao.NewOrReplace(db, model); err != nil {
+		logging.Error(ctx, "[SetTransactionLimit] failed to set limit: %v", err)
+		return nil, err
+	}
+
+	return &SetTransactionLimitRes{}, nil
+}
+
+func (impl *WalletImpl) DeleteTransactionLimit(ctx context.Context, in *DeleteTransactionLimitReq) (*DeleteTransactionLimitRes, error) {
+
+	db := database.GetDB()
+	if err := transactionLimitDao.Delete(db, &transactionLimitDao.QueryModel{
+		ID: &in.Id,
+	}); err != nil {
+		logging.Error(ctx, "[DeleteTransactionLimit] failed to delete limit %d: %v", in.Id, err)
+		return nil, err
+	}
+
+	return &DeleteTransactionLimitRes{}, nil
+}
+
+func (impl *WalletImpl) GetTransactionLimits(ctx context.Context, in *GetTransactionLimitsReq) (*GetTransactionLimitsRes, error) {
+
+	db := database.GetDB()
+	query := &transactionLimitDao.QueryModel{
+		Currency: in.Currency,
+	}
+	if in.WalletID != nil {
+		query.WalletID = []uint64{*in.WalletID}
+	}
+
+	models, err := transactionLimitDao.Gets(db, query)
+	if err != nil {
+		logging.Error(ctx, "[GetTransactionLimits] failed to get limits: %v", err)
+		return nil, err
+	}
+
+	res := &GetTransactionLimitsRes{}
+	for _, m := range models {
+		limit := &TransactionLimit{
+			Id:       m.ID,
+			Currency: m.Currency,
+			Action:   wallet.Action(m.Action),
+			WalletID: m.WalletID,
+		}
+		if m.MaxAmount.Valid {
+			maxAmount := m.MaxAmount.Decimal.String()
+			limit.MaxAmount = &maxAmount
+		}
+		if m.DailyAmount.Valid {
+			dailyAmount := m.DailyAmount.Decimal.String()
+			limit.DailyAmount = &dailyAmount
+		}
+		if m.MonthlyAmount.Valid {
+			monthlyAmount := m.MonthlyAmount.Decimal.String()
+			limit.MonthlyAmount = &monthlyAmount
+		}
+		if m.DailyCount.Valid {
+			limit.DailyCount = &m.DailyCount.Int64
+		}
+		if m.MonthlyCount.Valid {
+			limit.MonthlyCount = &m.MonthlyCount.Int64
+		}
+		res.Limits = append(res.Limits, limit)
+	}
+
+	return res, nil
+}
+
+// checkLimits tells if the record stays within the limits of its action and
+// of all actions, counting the successful transactions of the wallet this UTC
+// day and month. It is called once tx has written the wallet of the record, so
+// that no other transaction of the wallet can succeed until tx ends, and it
+// counts with a locking read, which sees the transactions that succeeded after
+// the snapshot of tx was taken.
+func checkLimits(ctx context.Context, tx *gorm.DB, record *dbModels.TransactionRecordModel) error {
+
+	limits, err := transactionLimitDao.Gets(tx, &transactionLimitDao.QueryModel{
+		Currency: &record.Currency,
+		Action:   []dbModels.TransactionAction{dbModels.TransactionAction_NONE, record.Action},
+		WalletID: []uint64{0, record.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[checkLimits] failed to get limits of wallet %d: %v", record.WalletID, err)
+		return err
+	}
+
+	// limits are ordered by wallet, so the override of the wallet comes last
+	effective := map[dbModels.TransactionAction]*dbModels.TransactionLimitModel{}
+	for i := range limits {
+		effective[limits[i].Action] = &limits[i]
+	}
+
+	amount := record.Amount.Abs()
+	now := time.Now().UTC()
+	dayStart := now.Truncate(24 * time.Hour)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for action, limit := range effective {
+		if limit.MaxAmount.Valid && amount.GreaterThan(limit.MaxAmount.Decimal) {
+			logging.Error(ctx, "[checkLimits] transaction %d over limit %d: %v", record.ID, limit.ID, models.ErrMaxAmountExceeded)
+			return models.ErrMaxAmountExceeded
+		}
+
+		for _, period := range []struct {
+			from        time.Time
+			maxAmount   decimal.NullDecimal
+			maxCount    sql.NullInt64
+			amountError error
+			countError  error
+		}{
+			{dayStart, limit.DailyAmount, limit.DailyCount, models.ErrDailyAmountExceeded, models.ErrDailyCountExceeded},
+			{monthStart, limit.MonthlyAmount, limit.MonthlyCount, models.ErrMonthlyAmountExceeded, models.ErrMonthlyCountExceeded},
+		} {
+			if !period.maxAmount.Valid && !period.maxCount.Valid {
+				continue
+			}
+
+			from := period.from
+			query := &transactionRecordDao.QueryModel{
+				WalletID:    &record.WalletID,
+				Status:      []dbModels.TransactionStatus{dbModels.TransactionStatus_Success},
+				AppliedFrom: &from,
+				ForShare:    true,
+			}
+			if action != dbModels.TransactionAction_NONE {
+				query.Action = []dbModels.TransactionAction{action}
+			}
+			volume, err := transactionRecordDao.SumVolume(tx, query)
+			if err != nil {
+				logging.Error(ctx, "[checkLimits] failed to sum transactions of wallet %d: %v", record.WalletID, err)
+				return err
+			}
+
+			if period.maxAmount.Valid && volume.Amount.Add(amount).GreaterThan(period.maxAmount.Decimal) {
+				logging.Error(ctx, "[checkLimits] transaction %d over limit %d: %v", record.ID, limit.ID, period.amountError)
+				return period.amountError
+			}
+			if period.maxCount.Valid && volume.Count+1 > period.maxCount.Int64 {
+				logging.Error(ctx, "[checkLimits] transaction %d over limit %d: %v", record.ID, limit.ID, period.countError)
+				return period.countError
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+//go:build integration
+
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionLimitDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// TestCheckLimitsConcurrent applies more deposits to one wallet at once than
+// its daily count allows, under each lock strategy, and expects exactly the
+// allowed number to succeed. Like BenchmarkUpdateWallet, it runs against the
+// database of the DATABASE_* variables, and is skipped without them:
+//
+//	go test -tags integration ./service/wallet -run CheckLimitsConcurrent
+func TestCheckLimitsConcurrent(t *testing.T) {
+	if _, ok := os.LookupEnv("DATABASE_DIALECT"); !ok {
+		t.Skip("DATABASE_DIALECT not set, no database to test against")
+	}
+	ctx := context.Background()
+	database.Initialize(ctx)
+	db := database.GetDB()
+
+	defer func(strategy LockStrategy) {
+		lockStrategy = strategy
+	}(lockStrategy)
+
+	const (
+		dailyCount = 3
+		attempts   = 20
+	)
+
+	for _, strategy := range []struct {
+		name     string
+		strategy LockStrategy
+	}{
+		{"optimistic", LockStrategy_Optimistic},
+		{"pessimistic", LockStrategy_Pessimistic},
+	} {
+		t.Run(strategy.name, func(t *testing.T) {
+			lockStrategy = strategy.strategy
+
+			walletModel := newTestWallet(t, db, "LIMIT")
+
+			if _, err := transactionLimitDao.NewOrReplace(db, &dbModels.TransactionLimitModel{
+				Currency: walletModel.Currency,
+				WalletID: walletModel.ID,
+				DailyCount: sql.NullInt64{
+					Valid: true,
+					Int64: dailyCount,
+				},
+			}); err != nil {
+				t.Fatalf("failed to new limit: %v", err)
+			}
+
+			var (
+				wg        sync.WaitGroup
+				mu        sync.Mutex
+				succeeded int
+				exceeded  int
+			)
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					record := &dbModels.TransactionRecordModel{
+						MemberID: walletModel.MemberID,
+						WalletID: walletModel.ID,
+						Action:   dbModels.TransactionAction_Deposit,
+						Amount:   decimal.NewFromInt(1),
+						Currency: walletModel.Currency,
+						Status:   dbModels.TransactionStatus_Pending,
+					}
+					if _, err := transactionRecordDao.New(db, record); err != nil {
+						t.Errorf("failed to new transaction record: %v", err)
+						return
+					}
+					records := []*dbModels.TransactionRecordModel{record}
+					err := db.Transaction(func(tx *gorm.DB) error {
+						return applyRecords(ctx, tx, records, nil)
+					})
+					if err != nil {
+						failRecords(ctx, db, records)
+					}
+
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case err == nil:
+						succeeded++
+					case errors.Is(err, models.ErrDailyCountExceeded):
+						exceeded++
+					}
+				}()
+			}
+			wg.Wait()
+
+			if succeeded != dailyCount {
+				t.Errorf("%d transactions succeeded, want %d", succeeded, dailyCount)
+			}
+			if succeeded+exceeded != attempts {
+				t.Logf("%d transactions interrupted", attempts-succeeded-exceeded)
+			}
+
+			walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+				ID: []uint64{walletModel.ID},
+			})
+			if err != nil {
+				t.Fatalf("failed to get wallet: %v", err)
+			}
+			if !walletModel.Amount.Equal(decimal.NewFromInt(int64(succeeded))) {
+				t.Errorf("wallet holds %s after %d deposits of 1", walletModel.Amount, succeeded)
+			}
+		})
+	}
+}
+
+// newTestWallet opens an empty wallet in the currency for a member of its own,
+// and removes it with everything written about it when the test ends.
+func newTestWallet(tb testing.TB, db *gorm.DB, currency string) *dbModels.WalletModel {
+	walletModel := &dbModels.WalletModel{
+		MemberID: uint64(time.Now().UnixNano()),
+		Currency: currency,
+		Amount:   decimal.Zero,
+		Status:   dbModels.WalletStatus_Active,
+	}
+	if _, err := walletDao.New(db, walletModel); err != nil {
+		tb.Fatalf("failed to new wallet: %v", err)
+	}
+	tb.Cleanup(func() {
+		purgeTestWallet(tb, db, walletModel)
+	})
+	return walletModel
+}
+
+// purgeTestWallet hard-deletes the wallet, its records and limits, its ledger
+// entries and the system accounts of its currency, which only tests use.
+func purgeTestWallet(tb testing.TB, db *gorm.DB, walletModel *dbModels.WalletModel) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var entryIDs []uint64
+		if err := tx.Table("journal_line").
+			Joins("JOIN ledger_account ON ledger_account.id = journal_line.account_id").
+			Where("ledger_account.wallet_id = ?", walletModel.ID).
+			Distinct().
+			Pluck("journal_line.journal_entry_id", &entryIDs).Error; err != nil {
+			return err
+		}
+		for _, statement := range []struct {
+			sql  string
+			args []interface{}
+		}{
+			{"DELETE FROM journal_line WHERE journal_entry_id IN ?", []interface{}{append(entryIDs, 0)}},
+			{"DELETE FROM journal_entry WHERE id IN ?", []interface{}{append(entryIDs, 0)}},
+			{"DELETE FROM ledger_account WHERE currency = ?", []interface{}{walletModel.Currency}},
+			{"DELETE FROM outbox WHERE wallet_id = ?", []interface{}{walletModel.ID}},
+			{"DELETE FROM transaction_limit WHERE wallet_id = ?", []interface{}{walletModel.ID}},
+			{"DELETE FROM transaction_record WHERE wallet_id = ?", []interface{}{walletModel.ID}},
+			{"DELETE FROM wallet WHERE id = ?", []interface{}{walletModel.ID}},
+		} {
+			if err := tx.Exec(statement.sql, statement.args...).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		tb.Errorf("failed to purge wallet %d: %v", walletModel.ID, err)
+	}
+}
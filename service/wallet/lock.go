@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// LockStrategy is how updateWallet keeps concurrent changes of a wallet from
+// overwriting each other.
+type LockStrategy int
+
+const (
+	// LockStrategy_Optimistic reads the wallet without locking it and writes
+	// it only if its version is still the one read, retrying after a jittered
+	// backoff otherwise. It suits wallets rarely changed at the same time.
+	LockStrategy_Optimistic LockStrategy = iota
+	// LockStrategy_Pessimistic locks the wallet row with SELECT ... FOR UPDATE
+	// until the transaction ends, so that concurrent changes wait in turn
+	// instead of failing. It suits hot wallets.
+	LockStrategy_Pessimistic
+)
+
+// LockStrategyEnv is the setting choosing the lock strategy, "optimistic" or
+// "pessimistic".
+const LockStrategyEnv = "WALLET_LOCK_STRATEGY"
+
+const (
+	maxUpdateWalletRetries  = 10
+	updateWalletBackoffBase = 5 * time.Millisecond
+	updateWalletBackoffMax  = 500 * time.Millisecond
+)
+
+// lockStrategy is optimistic until SetLockStrategy says otherwise.
+var lockStrategy = LockStrategy_Optimistic
+
+// ParseLockStrategy returns the lock strategy named by the value of
+// LockStrategyEnv.
+func ParseLockStrategy(value string) (LockStrategy, error) {
+	switch value {
+	case "optimistic":
+		return LockStrategy_Optimistic, nil
+	case "pessimistic":
+		return LockStrategy_Pessimistic, nil
+	default:
+		return LockStrategy_Optimistic, fmt.Errorf("unknown lock strategy %q", value)
+	}
+}
+
+// SetLockStrategy sets how wallets are updated from now on. It is meant to be
+// called once at startup.
+func SetLockStrategy(strategy LockStrategy) {
+	lockStrategy = strategy
+}
+
+// updateWalletBackoff returns how long to wait before the retry, a random
+// duration up to a cap doubling with each retry, so that the writers of a hot
+// wallet spread out instead of colliding again.
+func updateWalletBackoff(retry int) time.Duration {
+	backoff := updateWalletBackoffBase << (retry - 1)
+	if backoff <= 0 || backoff > updateWalletBackoffMax {
+		backoff = updateWalletBackoffMax
+	}
+	return time.Duration(rand.Int63n(int64(backoff)) + 1)
+}
+
+// sleepContext waits for d, or returns early with the error of ctx.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,77 @@
+//go:build integration
+
+package wallet
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// BenchmarkUpdateWallet credits one wallet from many goroutines at once under
+// each lock strategy. The package only loads with the service configuration in
+// the environment, hence the build tag. It runs against the database of the
+// DATABASE_* variables, and is skipped without them:
+//
+//	go test -tags integration ./service/wallet -run '^$' -bench UpdateWallet -cpu 4,16,64
+func BenchmarkUpdateWallet(b *testing.B) {
+	if _, ok := os.LookupEnv("DATABASE_DIALECT"); !ok {
+		b.Skip("DATABASE_DIALECT not set, no database to benchmark against")
+	}
+	ctx := context.Background()
+	database.Initialize(ctx)
+	db := database.GetDB()
+
+	defer func(strategy LockStrategy) {
+		lockStrategy = strategy
+	}(lockStrategy)
+
+	for _, strategy := range []struct {
+		name     string
+		strategy LockStrategy
+	}{
+		{"optimistic", LockStrategy_Optimistic},
+		{"pessimistic", LockStrategy_Pessimistic},
+	} {
+		b.Run(strategy.name, func(b *testing.B) {
+			lockStrategy = strategy.strategy
+
+			walletModel := newTestWallet(b, db, "BENCH")
+
+			var interrupted, failed int64
+			b.SetParallelism(4)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					err := db.Transaction(func(tx *gorm.DB) error {
+						_, err := updateWallet(ctx, tx, walletModel.ID, func(w *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+							amount := w.Amount.Add(decimal.NewFromInt(1))
+							return &walletDao.UpdateModel{
+								Amount: &amount,
+							}, nil
+						})
+						return err
+					})
+					if errors.Is(err, common.ErrUpdateWalletInterrupted) {
+						atomic.AddInt64(&interrupted, 1)
+					} else if err != nil {
+						atomic.AddInt64(&failed, 1)
+					}
+				}
+			})
+			b.StopTimer()
+
+			b.ReportMetric(float64(interrupted)/float64(b.N), "interrupted/op")
+			b.ReportMetric(float64(failed)/float64(b.N), "failed/op")
+		})
+	}
+}
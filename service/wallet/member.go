@@ -0,0 +1,87 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/member"
+	"github.com/paper-trade-chatbot/be-wallet/service"
+	"google.golang.org/grpc/status"
+)
+
+// a member disabled in the member service may keep using its wallets this long
+const memberStatusExpiry = time.Minute
+
+func memberStatusKey(memberID uint64) string {
+	return fmt.Sprintf("member:%d:status", memberID)
+}
+
+// checkMember returns an error unless the member exists and is enabled in the
+// member service. The status of a member found is cached for a short while, a
+// member not found is asked again next time.
+func checkMember(ctx context.Context, memberID uint64) error {
+	memberStatus, err := getMemberStatus(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if memberStatus != member.StatusType_StatusType_Enabled {
+		logging.Error(ctx, "[checkMember] member %d is in status %s: %v", memberID, memberStatus.String(), common.ErrMemberDisabled)
+		return common.ErrMemberDisabled
+	}
+	return nil
+}
+
+// systemKey marks the context of a write the wallet service makes on its own.
+type systemKey struct{}
+
+// systemContext lets the writes made with ctx go without a committer. Only the
+// flows of the service itself, never a request, take it.
+func systemContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// checkCommitter returns an error unless the committer is an enabled member.
+// A write in a system context may have no committer.
+func checkCommitter(ctx context.Context, committerID uint64) error {
+	if committerID == 0 && ctx.Value(systemKey{}) != nil {
+		return nil
+	}
+	return checkMember(ctx, committerID)
+}
+
+func getMemberStatus(ctx context.Context, memberID uint64) (member.StatusType, error) {
+	r, _ := cache.GetRedis()
+	if cached, err := r.Get(ctx, memberStatusKey(memberID)).Int(); err == nil {
+		return member.StatusType(cached), nil
+	}
+
+	// the member service still takes 32 bit ids
+	if memberID == 0 || memberID > math.MaxInt32 {
+		logging.Error(ctx, "[getMemberStatus] invalid member id %d: %v", memberID, common.ErrNoSuchMember)
+		return member.StatusType_StatusType_None, common.ErrNoSuchMember
+	}
+	res, err := service.Impl.MemberIntf.GetMember(ctx, &member.GetMemberReq{
+		Member: &member.GetMemberReq_Id{
+			Id: int32(memberID),
+		},
+	})
+	if status.Code(err) == status.Code(common.ErrNoSuchMember) || (err == nil && res.GetMember() == nil) {
+		logging.Error(ctx, "[getMemberStatus] no such member %d: %v", memberID, common.ErrNoSuchMember)
+		return member.StatusType_StatusType_None, common.ErrNoSuchMember
+	}
+	if err != nil {
+		logging.Error(ctx, "[getMemberStatus] failed to get member %d: %v", memberID, err)
+		return member.StatusType_StatusType_None, err
+	}
+
+	memberStatus := res.GetMember().GetStatus()
+	if err := r.Set(ctx, memberStatusKey(memberID), int32(memberStatus), memberStatusExpiry).Err(); err != nil {
+		logging.Warn(ctx, "[getMemberStatus] failed to cache status of member %d: %v", memberID, err)
+	}
+	return memberStatus, nil
+}
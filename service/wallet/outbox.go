@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/outboxDao"
+	"gorm.io/gorm"
+)
+
+// WalletEventStreamKey is the redis stream of wallet events. Each entry holds
+// the outbox id, the event type, the wallet id and the JSON of the eventPayload.
+// Events of a wallet are in the order they happened. An event may be published
+// more than once, consumers skip the outbox ids they have seen.
+const WalletEventStreamKey = "wallet:events"
+
+const (
+	walletEventStreamMaxLen = 100000
+	relayBatchSize          = 500
+	// only one relay publishes at a time, so that events stay in order. The
+	// lock is extended before each batch.
+	relayLockKey    = "outbox:relay"
+	relayLockExpiry = time.Minute
+)
+
+type RelayOutboxReq struct{}
+
+type RelayOutboxRes struct {
+	Published int
+}
+
+// RelayOutbox publishes the outbox events not published yet, oldest first.
+func (impl *WalletImpl) RelayOutbox(ctx context.Context, in *RelayOutboxReq) (*RelayOutboxRes, error) {
+
+	db := database.GetDB()
+	res := &RelayOutboxRes{}
+
+	lock, err := lockJob(ctx, relayLockKey, relayLockExpiry)
+	if err != nil {
+		logging.Error(ctx, "[RelayOutbox] failed to lock relay: %v", err)
+		return nil, err
+	}
+	if lock == nil {
+		return res, nil
+	}
+	defer lock.unlock(ctx)
+
+	r, _ := cache.GetRedis()
+	published := false
+	for ctx.Err() == nil {
+		// a relay that lost the lock may publish out of order with the next
+		if locked, err := lock.extend(ctx); err != nil || !locked {
+			logging.Error(ctx, "[RelayOutbox] lost relay lock: %v", err)
+			return res, err
+		}
+
+		events, err := outboxDao.Gets(db, &outboxDao.QueryModel{
+			Published: &published,
+			Limit:     relayBatchSize,
+		})
+		if err != nil {
+			logging.Error(ctx, "[RelayOutbox] failed to get outbox events: %v", err)
+			return res, err
+		}
+		if len(events) == 0 {
+			return res, nil
+		}
+
+		id := make([]uint64, 0, len(events))
+		var publishErr error
+		for _, e := range events {
+			if publishErr = r.XAdd(ctx, &redis.XAddArgs{
+				Stream: WalletEventStreamKey,
+				MaxLen: walletEventStreamMaxLen,
+				Approx: true,
+				Values: map[string]interface{}{
+					"id":       e.ID,
+					"type":     int(e.EventType),
+					"walletId": e.WalletID,
+					"payload":  e.Payload,
+				},
+			}).Err(); publishErr != nil {
+				logging.Error(ctx, "[RelayOutbox] failed to publish outbox event %d: %v", e.ID, publishErr)
+				break
+			}
+			id = append(id, e.ID)
+		}
+
+		// mark what made it even if the rest did not, so it is not sent again,
+		// and queue it to the webhooks along
+		if len(id) > 0 {
+			if err := db.Transaction(func(tx *gorm.DB) error {
+				if err := queueWebhookDeliveries(ctx, tx, events[:len(id)]); err != nil {
+					return err
+				}
+				return outboxDao.MarkPublished(tx, id, time.Now())
+			}); err != nil {
+				logging.Error(ctx, "[RelayOutbox] failed to mark outbox events published: %v", err)
+				return res, err
+			}
+			res.Published += len(id)
+		}
+		if publishErr != nil {
+			return res, publishErr
+		}
+	}
+
+	return res, ctx.Err()
+}
@@ -0,0 +1,323 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletHoldDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// a prepared transaction neither confirmed nor cancelled in time is cancelled
+const defaultPrepareTimeout = 15 * time.Minute
+
+// PrepareTransaction leaves a pending transaction record, reserving the funds
+// of a debit with a hold so that the confirmation can not run short.
+func (impl *WalletImpl) PrepareTransaction(ctx context.Context, in *wallet.PrepareTransactionReq) (*wallet.TransactionRes, error) {
+
+	db := database.GetDB()
+	amount, err := decimal.NewFromString(in.Amount)
+	if err != nil {
+		logging.Error(ctx, "[PrepareTransaction] failed to cast amount to decimal: %v", err)
+		return nil, err
+	}
+	// bonuses are granted by GrantCampaignBonus, within the budget of a campaign
+	if dbModels.TransactionAction(in.Action) == dbModels.TransactionAction_Bonus {
+		logging.Error(ctx, "[PrepareTransaction] bonus outside of a campaign: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	key, err := idempotencyKey(in.IdempotencyKey)
+	if err != nil {
+		logging.Error(ctx, "[PrepareTransaction] invalid idempotency key: %v", err)
+		return nil, err
+	}
+	prepared := func() *dbModels.TransactionRecordModel {
+		if key == "" {
+			return nil
+		}
+		record, _ := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			IdempotencyKey: &key,
+		})
+		return record
+	}
+	if record := prepared(); record != nil {
+		logging.Info(ctx, "[PrepareTransaction] idempotency key %s already used by transaction %d", key, record.ID)
+		if err := checkReplay(ctx, db, key, record, true, in.WalletID, in.Action, in.Currency, amount); err != nil {
+			return nil, err
+		}
+		return transactionRes(record), nil
+	}
+
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+		ID: []uint64{in.WalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[PrepareTransaction] failed to get wallet %d: %v", in.WalletID, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		logging.Error(ctx, "[PrepareTransaction] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	amount, err = walletAmount(ctx, db, walletModel, in.Currency, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultPrepareTimeout
+	if in.TimeoutSeconds > 0 {
+		timeout = time.Duration(in.TimeoutSeconds) * time.Second
+	}
+
+	transactionRecord := &dbModels.TransactionRecordModel{
+		MemberID:    walletModel.MemberID,
+		WalletID:    in.WalletID,
+		Action:      dbModels.TransactionAction(in.Action),
+		Amount:      amount,
+		Currency:    in.Currency,
+		CommitterID: in.CommitterID,
+		Status:      dbModels.TransactionStatus_Pending,
+		PreparedUntil: sql.NullTime{
+			Valid: true,
+			Time:  time.Now().Add(timeout),
+		},
+	}
+	if in.Remark != nil {
+		transactionRecord.Remark = sql.NullString{
+			Valid:  true,
+			String: *in.Remark,
+		}
+	}
+	if key != "" {
+		transactionRecord.IdempotencyKey = sql.NullString{
+			Valid:  true,
+			String: key,
+		}
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if _, err := transactionRecordDao.New(tx, transactionRecord); err != nil {
+			logging.Error(ctx, "[PrepareTransaction] failed to new transaction record: %v", err)
+			return err
+		}
+		if !amount.IsNegative() {
+			return nil
+		}
+
+		hold := &dbModels.WalletHoldModel{
+			MemberID:    walletModel.MemberID,
+			WalletID:    walletModel.ID,
+			Amount:      amount.Neg(),
+			Currency:    walletModel.Currency,
+			Status:      dbModels.WalletHoldStatus_Held,
+			CommitterID: in.CommitterID,
+			TransactionRecordID: sql.NullInt64{
+				Valid: true,
+				Int64: int64(transactionRecord.ID),
+			},
+		}
+		if err := placeHold(ctx, tx, hold); err != nil {
+			return err
+		}
+		if _, err := walletHoldDao.New(tx, hold); err != nil {
+			logging.Error(ctx, "[PrepareTransaction] failed to new hold: %v", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		// a concurrent retry with the same key may have won the unique index
+		if record := prepared(); record != nil {
+			if err := checkReplay(ctx, db, key, record, true, in.WalletID, in.Action, in.Currency, amount); err != nil {
+				return nil, err
+			}
+			return transactionRes(record), nil
+		}
+		return nil, err
+	}
+	if amount.IsNegative() {
+		refreshWalletCache(ctx, db, walletModel.ID)
+	}
+
+	transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		ID: &transactionRecord.ID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[PrepareTransaction] failed to get transaction record: %v", err)
+		return nil, err
+	}
+
+	return transactionRes(transactionRecord), nil
+}
+
+// ConfirmTransaction applies a prepared transaction. Confirming it again
+// returns the same result, and a failed confirmation leaves it pending to be
+// retried.
+func (impl *WalletImpl) ConfirmTransaction(ctx context.Context, in *wallet.ConfirmTransactionReq) (*wallet.TransactionRes, error) {
+
+	db := database.GetDB()
+	transactionRecord, err := preparedTransaction(ctx, db, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if transactionRecord.Status == dbModels.TransactionStatus_Success {
+		return transactionRes(transactionRecord), nil
+	}
+	if transactionRecord.Status != dbModels.TransactionStatus_Pending {
+		logging.Error(ctx, "[ConfirmTransaction] transaction %d is not pending: %v", in.Id, models.ErrTransactionNotPrepared)
+		return nil, models.ErrTransactionNotPrepared
+	}
+	// past its deadline it waits for RecoverPendingTransactions to cancel it
+	if transactionRecord.PreparedUntil.Valid && time.Now().After(transactionRecord.PreparedUntil.Time) {
+		logging.Error(ctx, "[ConfirmTransaction] transaction %d expired at %v: %v", in.Id, transactionRecord.PreparedUntil.Time, models.ErrTransactionExpired)
+		return nil, models.ErrTransactionExpired
+	}
+
+	hold, err := walletHoldDao.Get(db, &walletHoldDao.QueryModel{
+		TransactionRecordID: &transactionRecord.ID,
+		Status:              []dbModels.WalletHoldStatus{dbModels.WalletHoldStatus_Held},
+	})
+	if err != nil {
+		logging.Error(ctx, "[ConfirmTransaction] failed to get hold of transaction %d: %v", in.Id, err)
+		return nil, err
+	}
+
+	records := []*dbModels.TransactionRecordModel{transactionRecord}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if hold == nil {
+			return applyRecords(ctx, tx, records, nil)
+		}
+
+		status := dbModels.WalletHoldStatus_Captured
+		if err := walletHoldDao.Modify(tx, hold, &walletHoldDao.UpdateModel{
+			Status: &status,
+		}); err != nil {
+			logging.Error(ctx, "[ConfirmTransaction] failed to modify hold %d: %v", hold.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return models.ErrHoldNotActive
+			}
+			return err
+		}
+		return applyRecords(ctx, tx, records, func(_ *dbModels.TransactionRecordModel, _ *dbModels.WalletModel, update *walletDao.UpdateModel) error {
+			heldAmount := update.HeldAmount.Sub(hold.Amount)
+			update.HeldAmount = &heldAmount
+			return nil
+		})
+	})
+	if err != nil {
+		logging.Error(ctx, "[ConfirmTransaction] failed to confirm transaction %d: %v", in.Id, err)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, transactionRecord.WalletID)
+
+	transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		ID: &transactionRecord.ID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[ConfirmTransaction] failed to get modified transaction record: %v", err)
+		return nil, err
+	}
+
+	return transactionRes(transactionRecord), nil
+}
+
+// CancelTransaction fails a prepared transaction and releases its hold.
+// Cancelling it again succeeds without doing anything.
+func (impl *WalletImpl) CancelTransaction(ctx context.Context, in *wallet.CancelTransactionReq) (*wallet.CancelTransactionRes, error) {
+
+	db := database.GetDB()
+	transactionRecord, err := preparedTransaction(ctx, db, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if transactionRecord.Status == dbModels.TransactionStatus_Failed {
+		return &wallet.CancelTransactionRes{}, nil
+	}
+	if transactionRecord.Status != dbModels.TransactionStatus_Pending {
+		logging.Error(ctx, "[CancelTransaction] transaction %d is not pending: %v", in.Id, models.ErrTransactionNotPrepared)
+		return nil, models.ErrTransactionNotPrepared
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		return cancelPrepared(ctx, tx, transactionRecord, in.Remark)
+	}); err != nil {
+		logging.Error(ctx, "[CancelTransaction] failed to cancel transaction %d: %v", in.Id, err)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, transactionRecord.WalletID)
+
+	return &wallet.CancelTransactionRes{}, nil
+}
+
+func preparedTransaction(ctx context.Context, db *gorm.DB, id uint64) (*dbModels.TransactionRecordModel, error) {
+	record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+		ID: &id,
+	})
+	if err != nil {
+		logging.Error(ctx, "[preparedTransaction] failed to get transaction record %d: %v", id, err)
+		return nil, err
+	}
+	if record == nil {
+		logging.Error(ctx, "[preparedTransaction] no such transaction record %d: %v", id, common.ErrNoSuchTransactionRecord)
+		return nil, common.ErrNoSuchTransactionRecord
+	}
+	if !record.PreparedUntil.Valid {
+		logging.Error(ctx, "[preparedTransaction] transaction %d is not prepared: %v", id, models.ErrTransactionNotPrepared)
+		return nil, models.ErrTransactionNotPrepared
+	}
+	return record, nil
+}
+
+// cancelPrepared marks the prepared record failed and releases its hold.
+func cancelPrepared(ctx context.Context, tx *gorm.DB, record *dbModels.TransactionRecordModel, remark *string) error {
+	status := dbModels.TransactionStatus_Failed
+	update := &transactionRecordDao.UpdateModel{
+		Status:         &status,
+		IdempotencyKey: &sql.NullString{},
+	}
+	if remark != nil {
+		update.Remark = &sql.NullString{
+			Valid:  true,
+			String: *remark,
+		}
+	}
+	if err := transactionRecordDao.Modify(tx, record, update); err != nil {
+		logging.Error(ctx, "[cancelPrepared] failed to modify transaction record %d: %v", record.ID, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrTransactionNotPrepared
+		}
+		return err
+	}
+	if err := writeOutbox(ctx, tx, failedEvent(record)); err != nil {
+		return err
+	}
+
+	hold, err := walletHoldDao.Get(tx, &walletHoldDao.QueryModel{
+		TransactionRecordID: &record.ID,
+		Status:              []dbModels.WalletHoldStatus{dbModels.WalletHoldStatus_Held},
+	})
+	if err != nil {
+		logging.Error(ctx, "[cancelPrepared] failed to get hold of transaction %d: %v", record.ID, err)
+		return err
+	}
+	if hold == nil {
+		return nil
+	}
+	return releaseHold(ctx, tx, hold)
+}
@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/currencyDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// ProvisionMemberWalletsReq is sent by the member service once a member is
+// created.
+type ProvisionMemberWalletsReq struct {
+	MemberID    uint64
+	CommitterID uint64
+}
+
+type ProvisionedWallet struct {
+	WalletID uint64
+	Currency string
+	// the deposit of the starting amount, if any
+	Deposit *wallet.TransactionRes
+}
+
+type ProvisionMemberWalletsRes struct {
+	Wallets []*ProvisionedWallet
+}
+
+// ProvisionMemberWallets opens a wallet of each enabled provision currency for
+// the member and deposits its starting amount. Each deposit is keyed by member
+// and currency, so provisioning again only fills in what is missing and never
+// credits a starting amount twice.
+func (impl *WalletImpl) ProvisionMemberWallets(ctx context.Context, in *ProvisionMemberWalletsReq) (*ProvisionMemberWalletsRes, error) {
+
+	db := database.GetDB()
+	if in.MemberID == 0 {
+		logging.Error(ctx, "[ProvisionMemberWallets] missing member id: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkMember(ctx, in.MemberID); err != nil {
+		return nil, err
+	}
+
+	enabled := true
+	provision := true
+	currencies, err := currencyDao.Gets(db, &currencyDao.QueryModel{
+		Enabled:   &enabled,
+		Provision: &provision,
+	})
+	if err != nil {
+		logging.Error(ctx, "[ProvisionMemberWallets] failed to get provision currencies: %v", err)
+		return nil, err
+	}
+
+	res := &ProvisionMemberWalletsRes{}
+	for _, c := range currencies {
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			model := &dbModels.WalletModel{
+				MemberID: in.MemberID,
+				Currency: c.Code,
+				Amount:   decimal.Zero,
+				Status:   dbModels.WalletStatus_Active,
+			}
+			created, err := walletDao.NewIfNotExist(tx, model)
+			if err != nil || created == 0 {
+				return err
+			}
+			return writeOutbox(ctx, tx, walletEvent(dbModels.WalletEventType_WalletCreated, model))
+		}); err != nil {
+			logging.Error(ctx, "[ProvisionMemberWallets] failed to new %s wallet of member %d: %v", c.Code, in.MemberID, err)
+			return nil, err
+		}
+		walletModel, err := memberWallet(ctx, db, in.MemberID, c.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		provisioned := &ProvisionedWallet{
+			WalletID: walletModel.ID,
+			Currency: walletModel.Currency,
+		}
+		if c.StartingAmount.IsPositive() {
+			remark := "starting balance"
+			key := fmt.Sprintf("provision:%d:%s", in.MemberID, c.Code)
+			provisioned.Deposit, err = impl.Transaction(systemContext(ctx), &wallet.TransactionReq{
+				WalletID:       walletModel.ID,
+				Action:         wallet.Action(dbModels.TransactionAction_Deposit),
+				Amount:         c.StartingAmount.String(),
+				Currency:       c.Code,
+				CommitterID:    in.CommitterID,
+				Remark:         &remark,
+				IdempotencyKey: &key,
+			})
+			if err != nil {
+				logging.Error(ctx, "[ProvisionMemberWallets] failed to deposit starting amount to wallet %d: %v", walletModel.ID, err)
+				return nil, err
+			}
+			if provisioned.Deposit.Status != wallet.Status(dbModels.TransactionStatus_Success) {
+				logging.Error(ctx, "[ProvisionMemberWallets] starting deposit %d of wallet %d failed: %v", provisioned.Deposit.Id, walletModel.ID, common.ErrTransactionNotSuccess)
+				return nil, common.ErrTransactionNotSuccess
+			}
+		}
+		res.Wallets = append(res.Wallets, provisioned)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,168 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/reconciliationDiscrepancyDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/reconciliation"
+	"gorm.io/gorm"
+)
+
+// Reconcile replays the successful and rolled back transaction records of
+// each wallet of the member, or of every member without one, comparing their sum with the wallet amount and the before
+// amount of each change with the after amount of the previous one. The
+// discrepancies found are saved under the returned RunID.
+func (impl *WalletImpl) Reconcile(ctx context.Context, in *wallet.ReconcileReq) (*wallet.ReconcileRes, error) {
+
+	db := database.GetDB()
+	runID, _ := uuid.NewV4()
+	res := &wallet.ReconcileRes{
+		RunID: runID.String(),
+	}
+
+	query := &walletDao.QueryModel{}
+	if in.MemberID != nil {
+		query.MemberID = []uint64{*in.MemberID}
+	}
+	walletModels, err := walletDao.Gets(db, query)
+	if err != nil {
+		logging.Error(ctx, "[Reconcile] failed to get wallets: %v", err)
+		return nil, err
+	}
+
+	memberIDs := []uint64{}
+	seen := map[uint64]bool{}
+	for _, w := range walletModels {
+		if !seen[w.MemberID] {
+			seen[w.MemberID] = true
+			memberIDs = append(memberIDs, w.MemberID)
+		}
+	}
+
+	for _, memberID := range memberIDs {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		walletCount, discrepancies, err := reconcileMember(ctx, db, res.RunID, memberID)
+		if err != nil {
+			logging.Error(ctx, "[Reconcile] failed to reconcile member %d: %v", memberID, err)
+			return res, err
+		}
+		res.WalletCount += int64(walletCount)
+		res.Discrepancies += int64(len(discrepancies))
+	}
+
+	logging.Info(ctx, "[Reconcile] run %s reconciled %d wallets, found %d discrepancies", res.RunID, res.WalletCount, res.Discrepancies)
+	return res, nil
+}
+
+func (impl *WalletImpl) GetReconciliationDiscrepancies(ctx context.Context, in *wallet.GetReconciliationDiscrepanciesReq) (*wallet.GetReconciliationDiscrepanciesRes, error) {
+
+	db := database.GetDB()
+
+	query := &reconciliationDiscrepancyDao.QueryModel{
+		RunID:    in.RunID,
+		MemberID: in.MemberID,
+		WalletID: in.WalletID,
+	}
+	for _, t := range in.Type {
+		query.Type = append(query.Type, dbModels.ReconciliationDiscrepancyType(t))
+	}
+	if in.CreatedFrom != nil {
+		createdFrom := time.Unix(*in.CreatedFrom, 0)
+		query.CreatedFrom = &createdFrom
+	}
+	if in.CreatedTo != nil {
+		createdTo := time.Unix(*in.CreatedTo, 0)
+		query.CreatedTo = &createdTo
+	}
+
+	models, paginationInfo, err := reconciliationDiscrepancyDao.GetsWithPagination(db, query, in.Pagination)
+	if err != nil {
+		logging.Error(ctx, "[GetReconciliationDiscrepancies] failed to get discrepancies: %v", err)
+		return nil, err
+	}
+
+	res := &wallet.GetReconciliationDiscrepanciesRes{
+		PaginationInfo: paginationInfo,
+	}
+	for _, m := range models {
+		discrepancy := &wallet.ReconciliationDiscrepancy{
+			Id:             m.ID,
+			RunID:          m.RunID,
+			MemberID:       m.MemberID,
+			WalletID:       m.WalletID,
+			Type:           wallet.ReconciliationDiscrepancyType(m.Type),
+			ExpectedAmount: m.ExpectedAmount.String(),
+			ActualAmount:   m.ActualAmount.String(),
+			Currency:       m.Currency,
+			CreatedAt:      m.CreatedAt.Unix(),
+		}
+		if m.TransactionRecordID.Valid {
+			transactionRecordID := uint64(m.TransactionRecordID.Int64)
+			discrepancy.TransactionRecordID = &transactionRecordID
+		}
+		res.Discrepancies = append(res.Discrepancies, discrepancy)
+	}
+
+	return res, nil
+}
+
+// reconcileMember checks the wallets of a member and saves what is wrong.
+// Wallets and records are read in one transaction so that they are from the
+// same snapshot even while transactions go on.
+func reconcileMember(ctx context.Context, db *gorm.DB, runID string, memberID uint64) (int, []*dbModels.ReconciliationDiscrepancyModel, error) {
+
+	var walletModels []dbModels.WalletModel
+	var records []dbModels.TransactionRecordModel
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		walletModels, err = walletDao.Gets(tx, &walletDao.QueryModel{
+			MemberID: []uint64{memberID},
+		})
+		if err != nil {
+			return err
+		}
+		records, err = transactionRecordDao.Gets(tx, &transactionRecordDao.QueryModel{
+			MemberID: &memberID,
+			Status: []dbModels.TransactionStatus{
+				dbModels.TransactionStatus_Success,
+				dbModels.TransactionStatus_Rollback,
+			},
+		})
+		return err
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+
+	recordsOfWallet := map[uint64][]*dbModels.TransactionRecordModel{}
+	for i := range records {
+		r := &records[i]
+		recordsOfWallet[r.WalletID] = append(recordsOfWallet[r.WalletID], r)
+	}
+
+	discrepancies := []*dbModels.ReconciliationDiscrepancyModel{}
+	for i := range walletModels {
+		discrepancies = append(discrepancies, reconciliation.Wallet(runID, &walletModels[i], recordsOfWallet[walletModels[i].ID])...)
+	}
+	if len(discrepancies) == 0 {
+		return len(walletModels), discrepancies, nil
+	}
+
+	if _, err := reconciliationDiscrepancyDao.News(db, discrepancies); err != nil {
+		logging.Error(ctx, "[reconcileMember] failed to new discrepancies: %v", err)
+		return 0, nil, err
+	}
+	logging.Warn(ctx, "[reconcileMember] member %d has %d discrepancies in run %s", memberID, len(discrepancies), runID)
+	return len(walletModels), discrepancies, nil
+}
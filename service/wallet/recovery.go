@@ -0,0 +1,85 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"gorm.io/gorm"
+)
+
+// RecoverPendingTransactionsReq recovers transactions left pending for longer
+// than OlderThanSeconds, and prepared transactions past their deadline.
+type RecoverPendingTransactionsReq struct {
+	OlderThanSeconds int64
+}
+
+// RecoverPendingTransactionsRes reports the transaction records changed.
+type RecoverPendingTransactionsRes struct {
+	// never reached the wallet, marked failed
+	Failed []uint64
+	// prepared but neither confirmed nor cancelled in time, cancelled
+	Cancelled []uint64
+}
+
+// RecoverPendingTransactions resolves the records stuck in pending when the
+// process died in the middle of a transaction. The wallet update, the ledger
+// entry and the successful record are committed together, so a stuck record
+// never moved any money.
+func (impl *WalletImpl) RecoverPendingTransactions(ctx context.Context, in *RecoverPendingTransactionsReq) (*RecoverPendingTransactionsRes, error) {
+
+	db := database.GetDB()
+	res := &RecoverPendingTransactionsRes{}
+	now := time.Now()
+
+	createdBefore := now.Add(-time.Duration(in.OlderThanSeconds) * time.Second)
+	prepared := false
+	stuck, err := transactionRecordDao.Gets(db, &transactionRecordDao.QueryModel{
+		Status:        []dbModels.TransactionStatus{dbModels.TransactionStatus_Pending},
+		CreatedBefore: &createdBefore,
+		Prepared:      &prepared,
+	})
+	if err != nil {
+		logging.Error(ctx, "[RecoverPendingTransactions] failed to get pending transaction records: %v", err)
+		return nil, err
+	}
+
+	records := make([]*dbModels.TransactionRecordModel, 0, len(stuck))
+	for i := range stuck {
+		records = append(records, &stuck[i])
+	}
+	// a record applied or failed since it was read is left out
+	for _, record := range failRecords(ctx, db, records) {
+		res.Failed = append(res.Failed, record.ID)
+	}
+
+	prepared = true
+	expired, err := transactionRecordDao.Gets(db, &transactionRecordDao.QueryModel{
+		Status:         []dbModels.TransactionStatus{dbModels.TransactionStatus_Pending},
+		Prepared:       &prepared,
+		PreparedBefore: &now,
+	})
+	if err != nil {
+		logging.Error(ctx, "[RecoverPendingTransactions] failed to get expired prepared transaction records: %v", err)
+		return res, err
+	}
+
+	remark := "prepared transaction expired"
+	for i := range expired {
+		record := &expired[i]
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return cancelPrepared(ctx, tx, record, &remark)
+		}); err != nil {
+			logging.Error(ctx, "[RecoverPendingTransactions] failed to cancel transaction %d: %v", record.ID, err)
+			continue
+		}
+		refreshWalletCache(ctx, db, record.WalletID)
+		res.Cancelled = append(res.Cancelled, record.ID)
+	}
+
+	logging.Info(ctx, "[RecoverPendingTransactions] failed %v, cancelled %v", res.Failed, res.Cancelled)
+	return res, nil
+}
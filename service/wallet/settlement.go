@@ -0,0 +1,189 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/cache"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletHoldDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletStatusLogDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/ledger"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// CloseMemberWallets settles the wallets of a deleted member: it cancels the
+// prepared transactions and releases the holds, moves the remaining balance to
+// the settlement account and closes the wallet. The wallets are kept, closed,
+// with their transaction history. Closing them again does nothing. It refuses
+// a member the member service still has.
+func (impl *WalletImpl) CloseMemberWallets(ctx context.Context, in *wallet.CloseMemberWalletsReq) (*wallet.CloseMemberWalletsRes, error) {
+
+	db := database.GetDB()
+	if in.MemberID == 0 || in.Reason == "" || len(in.Reason) > maxStatusReasonLength {
+		logging.Error(ctx, "[CloseMemberWallets] invalid request of member %d: %v", in.MemberID, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	// the member may be gone already, do not let the cache tell otherwise
+	r, _ := cache.GetRedis()
+	if err := r.Del(ctx, memberStatusKey(in.MemberID)).Err(); err != nil {
+		logging.Warn(ctx, "[CloseMemberWallets] failed to clear cached status of member %d: %v", in.MemberID, err)
+	}
+	_, err := getMemberStatus(ctx, in.MemberID)
+	if err == nil {
+		logging.Error(ctx, "[CloseMemberWallets] member %d still exists: %v", in.MemberID, models.ErrMemberNotDeleted)
+		return nil, models.ErrMemberNotDeleted
+	}
+	if !errors.Is(err, common.ErrNoSuchMember) {
+		return nil, err
+	}
+
+	walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{
+		MemberID: []uint64{in.MemberID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[CloseMemberWallets] failed to get wallets of member %d: %v", in.MemberID, err)
+		return nil, err
+	}
+
+	res := &wallet.CloseMemberWalletsRes{}
+	for _, w := range walletModels {
+		closed := &wallet.ClosedWallet{
+			WalletID: w.ID,
+			Currency: w.Currency,
+		}
+		var settlement *dbModels.TransactionRecordModel
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			settlement, err = settleWallet(ctx, tx, w.ID, in)
+			return err
+		}); err != nil {
+			logging.Error(ctx, "[CloseMemberWallets] failed to settle wallet %d: %v", w.ID, err)
+			return nil, err
+		}
+		refreshWalletCache(ctx, db, w.ID)
+		if settlement != nil {
+			closed.Settlement = transactionRes(settlement)
+		}
+		res.Wallets = append(res.Wallets, closed)
+	}
+
+	return res, nil
+}
+
+// settleWallet empties and closes the wallet, returning the settlement record
+// if there was a balance to settle. A settlement is not a transaction of the
+// member, so neither the wallet status nor the transaction limits apply.
+func settleWallet(ctx context.Context, tx *gorm.DB, walletID uint64, in *wallet.CloseMemberWalletsReq) (*dbModels.TransactionRecordModel, error) {
+	prepared := true
+	pending, err := transactionRecordDao.Gets(tx, &transactionRecordDao.QueryModel{
+		WalletID: &walletID,
+		Status:   []dbModels.TransactionStatus{dbModels.TransactionStatus_Pending},
+		Prepared: &prepared,
+	})
+	if err != nil {
+		logging.Error(ctx, "[settleWallet] failed to get prepared transactions of wallet %d: %v", walletID, err)
+		return nil, err
+	}
+	remark := "member deleted"
+	for i := range pending {
+		if err := cancelPrepared(ctx, tx, &pending[i], &remark); err != nil {
+			return nil, err
+		}
+	}
+
+	holds, err := walletHoldDao.Gets(tx, &walletHoldDao.QueryModel{
+		WalletID: &walletID,
+		Status:   []dbModels.WalletHoldStatus{dbModels.WalletHoldStatus_Held},
+	})
+	if err != nil {
+		logging.Error(ctx, "[settleWallet] failed to get holds of wallet %d: %v", walletID, err)
+		return nil, err
+	}
+	for i := range holds {
+		if err := releaseHold(ctx, tx, &holds[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var fromStatus dbModels.WalletStatus
+	beforeAmount := decimal.NewNullDecimal(decimal.Zero)
+	walletModel, err := updateWallet(ctx, tx, walletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+		fromStatus = walletModel.Status
+		beforeAmount.Decimal = walletModel.Amount
+		amount := decimal.Zero
+		status := dbModels.WalletStatus_Closed
+		return &walletDao.UpdateModel{
+			Amount: &amount,
+			Status: &status,
+		}, nil
+	})
+	if err != nil {
+		logging.Error(ctx, "[settleWallet] failed to close wallet %d: %v", walletID, err)
+		return nil, err
+	}
+
+	if fromStatus != dbModels.WalletStatus_Closed {
+		if _, err := walletStatusLogDao.New(tx, &dbModels.WalletStatusLogModel{
+			MemberID:   walletModel.MemberID,
+			WalletID:   walletModel.ID,
+			FromStatus: fromStatus,
+			ToStatus:   dbModels.WalletStatus_Closed,
+			OperatorID: in.OperatorID,
+			Reason:     in.Reason,
+		}); err != nil {
+			logging.Error(ctx, "[settleWallet] failed to new status log of wallet %d: %v", walletID, err)
+			return nil, err
+		}
+	}
+	if beforeAmount.Decimal.IsZero() {
+		return nil, nil
+	}
+
+	appliedAt := time.Now()
+	record := &dbModels.TransactionRecordModel{
+		MemberID:     walletModel.MemberID,
+		WalletID:     walletModel.ID,
+		Action:       dbModels.TransactionAction_Settlement,
+		Amount:       beforeAmount.Decimal.Neg(),
+		BeforeAmount: beforeAmount,
+		AfterAmount:  decimal.NewNullDecimal(decimal.Zero),
+		WalletVersion: sql.NullInt64{
+			Valid: true,
+			Int64: int64(walletModel.Version),
+		},
+		AppliedAt: sql.NullTime{
+			Valid: true,
+			Time:  appliedAt,
+		},
+		Currency:    walletModel.Currency,
+		CommitterID: in.OperatorID,
+		Status:      dbModels.TransactionStatus_Success,
+		Remark: sql.NullString{
+			Valid:  true,
+			String: remark,
+		},
+	}
+	if _, err := transactionRecordDao.New(tx, record); err != nil {
+		logging.Error(ctx, "[settleWallet] failed to new settlement of wallet %d: %v", walletID, err)
+		return nil, err
+	}
+	if err := writeOutbox(ctx, tx, recordEvent(dbModels.WalletEventType_Transaction, record, beforeAmount.Decimal, decimal.Zero, walletModel.Version, appliedAt)); err != nil {
+		return nil, err
+	}
+	if err := ledger.Post(ctx, tx, ledger.TransactionReference(record), []*dbModels.TransactionRecordModel{record}); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
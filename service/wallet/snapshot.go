@@ -0,0 +1,164 @@
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletBalanceSnapshotDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+)
+
+// TakeBalanceSnapshotsReq snapshots every wallet at the end of the UTC day
+// of Date. Taking a day again keeps the snapshots already taken.
+type TakeBalanceSnapshotsReq struct {
+	Date int64
+}
+
+type TakeBalanceSnapshotsRes struct {
+	Taken int
+}
+
+func (impl *WalletImpl) TakeBalanceSnapshots(ctx context.Context, in *TakeBalanceSnapshotsReq) (*TakeBalanceSnapshotsRes, error) {
+
+	db := database.GetDB()
+	day := time.Unix(in.Date, 0).UTC().Truncate(24 * time.Hour)
+	end := day.Add(24 * time.Hour)
+
+	walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{})
+	if err != nil {
+		logging.Error(ctx, "[TakeBalanceSnapshots] failed to get wallets: %v", err)
+		return nil, err
+	}
+
+	applied, err := transactionRecordDao.SumAmountByWalletAction(db, &transactionRecordDao.QueryModel{
+		Status: []dbModels.TransactionStatus{
+			dbModels.TransactionStatus_Success,
+			dbModels.TransactionStatus_Rollback,
+		},
+		AppliedFrom:   &day,
+		AppliedBefore: &end,
+	})
+	if err != nil {
+		logging.Error(ctx, "[TakeBalanceSnapshots] failed to sum applied records: %v", err)
+		return nil, err
+	}
+	rolledBack, err := transactionRecordDao.SumAmountByWalletAction(db, &transactionRecordDao.QueryModel{
+		Status:           []dbModels.TransactionStatus{dbModels.TransactionStatus_Rollback},
+		RolledBackFrom:   &day,
+		RolledBackBefore: &end,
+	})
+	if err != nil {
+		logging.Error(ctx, "[TakeBalanceSnapshots] failed to sum rolled back records: %v", err)
+		return nil, err
+	}
+
+	snapshots := map[uint64]*dbModels.WalletBalanceSnapshotModel{}
+	for _, w := range walletModels {
+		if w.CreatedAt != nil && !w.CreatedAt.Before(end) {
+			continue
+		}
+
+		// amounts are stored to the second, so the last second of a day
+		// includes all of it
+		opening, err := balanceAt(db, w.ID, day.Add(-time.Second))
+		if err != nil {
+			logging.Error(ctx, "[TakeBalanceSnapshots] failed to get opening balance of wallet %d: %v", w.ID, err)
+			return nil, err
+		}
+		closing, err := balanceAt(db, w.ID, end.Add(-time.Second))
+		if err != nil {
+			logging.Error(ctx, "[TakeBalanceSnapshots] failed to get closing balance of wallet %d: %v", w.ID, err)
+			return nil, err
+		}
+
+		snapshots[w.ID] = &dbModels.WalletBalanceSnapshotModel{
+			MemberID:      w.MemberID,
+			WalletID:      w.ID,
+			Currency:      w.Currency,
+			SnapshotDate:  day,
+			OpeningAmount: opening,
+			ClosingAmount: closing,
+		}
+	}
+	for _, s := range applied {
+		if snapshot, ok := snapshots[s.WalletID]; ok {
+			snapshot.AddActionAmount(s.Action, s.Amount)
+		}
+	}
+	for _, s := range rolledBack {
+		if snapshot, ok := snapshots[s.WalletID]; ok {
+			snapshot.AddActionAmount(s.Action, s.Amount.Neg())
+		}
+	}
+
+	models := make([]*dbModels.WalletBalanceSnapshotModel, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		models = append(models, snapshot)
+	}
+	res := &TakeBalanceSnapshotsRes{}
+	if len(models) == 0 {
+		return res, nil
+	}
+
+	res.Taken, err = walletBalanceSnapshotDao.NewsIfNotExist(db, models)
+	if err != nil {
+		logging.Error(ctx, "[TakeBalanceSnapshots] failed to new snapshots: %v", err)
+		return nil, err
+	}
+
+	logging.Info(ctx, "[TakeBalanceSnapshots] took %d snapshots of %s", res.Taken, day.Format("2006-01-02"))
+	return res, nil
+}
+
+func (impl *WalletImpl) GetBalanceSnapshots(ctx context.Context, in *wallet.GetBalanceSnapshotsReq) (*wallet.GetBalanceSnapshotsRes, error) {
+
+	db := database.GetDB()
+	query := &walletBalanceSnapshotDao.QueryModel{
+		MemberID: in.MemberID,
+		WalletID: in.WalletID,
+		Currency: in.Currency,
+	}
+	if in.DateFrom != nil {
+		dateFrom := time.Unix(*in.DateFrom, 0).UTC()
+		query.DateFrom = &dateFrom
+	}
+	if in.DateTo != nil {
+		dateTo := time.Unix(*in.DateTo, 0).UTC()
+		query.DateTo = &dateTo
+	}
+
+	models, paginationInfo, err := walletBalanceSnapshotDao.GetsWithPagination(db, query, in.Pagination)
+	if err != nil {
+		logging.Error(ctx, "[GetBalanceSnapshots] failed to get snapshots: %v", err)
+		return nil, err
+	}
+
+	res := &wallet.GetBalanceSnapshotsRes{
+		PaginationInfo: paginationInfo,
+	}
+	for _, m := range models {
+		res.Snapshots = append(res.Snapshots, &wallet.BalanceSnapshot{
+			MemberID:         m.MemberID,
+			WalletID:         m.WalletID,
+			Currency:         m.Currency,
+			Date:             m.SnapshotDate.Unix(),
+			OpeningAmount:    m.OpeningAmount.String(),
+			ClosingAmount:    m.ClosingAmount.String(),
+			DepositAmount:    m.DepositAmount.String(),
+			WithdrawAmount:   m.WithdrawAmount.String(),
+			BonusAmount:      m.BonusAmount.String(),
+			InterestAmount:   m.InterestAmount.String(),
+			PnLAmount:        m.PnLAmount().String(),
+			ExchangeAmount:   m.ExchangeAmount.String(),
+			SettlementAmount: m.SettlementAmount.String(),
+			ManuallyAmount:   m.ManuallyAmount.String(),
+		})
+	}
+
+	return res, nil
+}
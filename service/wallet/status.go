@@ -0,0 +1,162 @@
+package wallet
+
+import (
+	"context"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/general"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletStatusLogDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+const maxStatusReasonLength = 255
+
+// walletStatusChanges are the statuses a wallet can be set to from each status.
+// A closed wallet stays closed, and only CloseMemberWallets closes a wallet,
+// once it is settled.
+var walletStatusChanges = map[dbModels.WalletStatus][]dbModels.WalletStatus{
+	dbModels.WalletStatus_Active:     {dbModels.WalletStatus_Frozen, dbModels.WalletStatus_DebitOnly, dbModels.WalletStatus_CreditOnly},
+	dbModels.WalletStatus_Frozen:     {dbModels.WalletStatus_Active, dbModels.WalletStatus_DebitOnly, dbModels.WalletStatus_CreditOnly},
+	dbModels.WalletStatus_DebitOnly:  {dbModels.WalletStatus_Active, dbModels.WalletStatus_Frozen, dbModels.WalletStatus_CreditOnly},
+	dbModels.WalletStatus_CreditOnly: {dbModels.WalletStatus_Active, dbModels.WalletStatus_Frozen, dbModels.WalletStatus_DebitOnly},
+}
+
+type GetWalletStatusLogsReq struct {
+	MemberID   *uint64
+	WalletID   *uint64
+	OperatorID *uint64
+	Pagination *general.Pagination
+}
+
+type WalletStatusLog struct {
+	Id         uint64
+	MemberID   uint64
+	WalletID   uint64
+	FromStatus dbModels.WalletStatus
+	ToStatus   dbModels.WalletStatus
+	OperatorID uint64
+	Reason     string
+	CreatedAt  int64
+}
+
+type GetWalletStatusLogsRes struct {
+	Logs           []*WalletStatusLog
+	PaginationInfo *general.PaginationInfo
+}
+
+// SetWalletStatus changes the status of a wallet, logging who did it and why.
+func (impl *WalletImpl) SetWalletStatus(ctx context.Context, in *wallet.SetWalletStatusReq) (*wallet.SetWalletStatusRes, error) {
+
+	db := database.GetDB()
+	status := dbModels.WalletStatus(in.Status)
+	if status <= dbModels.WalletStatus_NONE || status > dbModels.WalletStatus_Closed ||
+		in.Reason == "" || len(in.Reason) > maxStatusReasonLength {
+		logging.Error(ctx, "[SetWalletStatus] invalid status %d of wallet %d: %v", in.Status, in.WalletID, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var fromStatus dbModels.WalletStatus
+		walletModel, err := updateWallet(ctx, tx, in.WalletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+			fromStatus = walletModel.Status
+			if fromStatus != status && !canChangeStatus(fromStatus, status) {
+				return nil, models.ErrWalletStatusChange
+			}
+			return &walletDao.UpdateModel{
+				Status: &status,
+			}, nil
+		})
+		if err != nil {
+			return err
+		}
+		if fromStatus == status {
+			return nil
+		}
+
+		_, err = walletStatusLogDao.New(tx, &dbModels.WalletStatusLogModel{
+			MemberID:   walletModel.MemberID,
+			WalletID:   walletModel.ID,
+			FromStatus: fromStatus,
+			ToStatus:   status,
+			OperatorID: in.OperatorID,
+			Reason:     in.Reason,
+		})
+		return err
+	})
+	if err != nil {
+		logging.Error(ctx, "[SetWalletStatus] failed to set status %d of wallet %d: %v", in.Status, in.WalletID, err)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, in.WalletID)
+
+	return &wallet.SetWalletStatusRes{}, nil
+}
+
+func (impl *WalletImpl) GetWalletStatusLogs(ctx context.Context, in *GetWalletStatusLogsReq) (*GetWalletStatusLogsRes, error) {
+
+	db := database.GetDB()
+	models, paginationInfo, err := walletStatusLogDao.GetsWithPagination(db, &walletStatusLogDao.QueryModel{
+		MemberID:   in.MemberID,
+		WalletID:   in.WalletID,
+		OperatorID: in.OperatorID,
+	}, in.Pagination)
+	if err != nil {
+		logging.Error(ctx, "[GetWalletStatusLogs] failed to get status logs: %v", err)
+		return nil, err
+	}
+
+	res := &GetWalletStatusLogsRes{
+		PaginationInfo: paginationInfo,
+	}
+	for _, m := range models {
+		res.Logs = append(res.Logs, &WalletStatusLog{
+			Id:         m.ID,
+			MemberID:   m.MemberID,
+			WalletID:   m.WalletID,
+			FromStatus: m.FromStatus,
+			ToStatus:   m.ToStatus,
+			OperatorID: m.OperatorID,
+			Reason:     m.Reason,
+			CreatedAt:  m.CreatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
+
+// canChangeStatus tells if a wallet can be set from one status to the other.
+func canChangeStatus(from dbModels.WalletStatus, to dbModels.WalletStatus) bool {
+	for _, status := range walletStatusChanges[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// checkWalletStatus tells if the wallet can take amount, a debit if
+// negative and a credit otherwise.
+func checkWalletStatus(walletModel *dbModels.WalletModel, amount decimal.Decimal) error {
+	switch walletModel.Status {
+	case dbModels.WalletStatus_Frozen:
+		return models.ErrWalletFrozen
+	case dbModels.WalletStatus_Closed:
+		return models.ErrWalletClosed
+	case dbModels.WalletStatus_DebitOnly:
+		if !amount.IsNegative() {
+			return models.ErrWalletDebitOnly
+		}
+	case dbModels.WalletStatus_CreditOnly:
+		if amount.IsNegative() {
+			return models.ErrWalletCreditOnly
+		}
+	}
+	return nil
+}
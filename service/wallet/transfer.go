@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// Transfer moves amount from one wallet to another in one transaction, and
+// returns the record of each side, linked by TransferID.
+func (impl *WalletImpl) Transfer(ctx context.Context, in *wallet.TransferReq) (*wallet.TransferRes, error) {
+
+	db := database.GetDB()
+	amount, err := decimal.NewFromString(in.Amount)
+	if err != nil {
+		logging.Error(ctx, "[Transfer] failed to cast amount to decimal: %v", err)
+		return nil, err
+	}
+	if !amount.IsPositive() || in.FromWalletID == in.ToWalletID {
+		logging.Error(ctx, "[Transfer] invalid transfer %s from %d to %d: %v", in.Amount, in.FromWalletID, in.ToWalletID, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	walletModels, err := walletDao.Gets(db, &walletDao.QueryModel{
+		ID: []uint64{in.FromWalletID, in.ToWalletID},
+	})
+	if err != nil {
+		logging.Error(ctx, "[Transfer] failed to get wallets: %v", err)
+		return nil, err
+	}
+	if len(walletModels) != 2 {
+		logging.Error(ctx, "[Transfer] no such wallet %d or %d: %v", in.FromWalletID, in.ToWalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	for i := range walletModels {
+		amount, err = walletAmount(ctx, db, &walletModels[i], in.Currency, amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !amount.IsPositive() {
+		logging.Error(ctx, "[Transfer] amount %s rounds to nothing: %v", in.Amount, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	transferID, _ := uuid.NewV4()
+	records := make([]*dbModels.TransactionRecordModel, 0, 2)
+	for _, w := range walletModels {
+		record := &dbModels.TransactionRecordModel{
+			MemberID:    w.MemberID,
+			WalletID:    w.ID,
+			Action:      dbModels.TransactionAction(in.Action),
+			Amount:      amount,
+			Currency:    in.Currency,
+			CommitterID: in.CommitterID,
+			Status:      dbModels.TransactionStatus_Pending,
+			TransferID: sql.NullString{
+				Valid:  true,
+				String: transferID.String(),
+			},
+		}
+		if w.ID == in.FromWalletID {
+			record.Amount = amount.Neg()
+		}
+		if in.Remark != nil {
+			record.Remark = sql.NullString{
+				Valid:  true,
+				String: *in.Remark,
+			}
+		}
+		records = append(records, record)
+	}
+
+	if _, err := transactionRecordDao.News(db, records); err != nil {
+		logging.Error(ctx, "[Transfer] failed to new transaction records: %v", err)
+		return nil, err
+	}
+
+	// lock wallets in id order so that opposite transfers can not deadlock
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].WalletID < records[j].WalletID
+	})
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return applyRecords(ctx, tx, records, nil)
+	})
+	if err != nil {
+		logging.Error(ctx, "[Transfer] failed to apply transfer %s: %v", transferID.String(), err)
+		failRecords(ctx, db, records)
+		return nil, err
+	}
+	refreshWalletCache(ctx, db, in.FromWalletID, in.ToWalletID)
+
+	res := &wallet.TransferRes{
+		TransferID: transferID.String(),
+	}
+	for _, record := range records {
+		record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			ID: &record.ID,
+		})
+		if err != nil {
+			logging.Error(ctx, "[Transfer] failed to get modified transaction record: %v", err)
+			return nil, err
+		}
+
+		if record.WalletID == in.FromWalletID {
+			res.From = transactionRes(record)
+		} else {
+			res.To = transactionRes(record)
+		}
+	}
+
+	return res, nil
+}
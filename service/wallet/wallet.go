@@ -6,13 +6,19 @@ import (
 	"errors"
 	"time"
 
+	"github.com/go-redis/redis/v9"
 	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/cache"
 	"github.com/paper-trade-chatbot/be-common/database"
 	"github.com/paper-trade-chatbot/be-common/logging"
 	"github.com/paper-trade-chatbot/be-proto/wallet"
 	"github.com/paper-trade-chatbot/be-wallet/dao/transactionRecordDao"
 	"github.com/paper-trade-chatbot/be-wallet/dao/walletDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
 	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/currency"
+	"github.com/paper-trade-chatbot/be-wallet/service/ledger"
+	"github.com/paper-trade-chatbot/be-wallet/service/rate"
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
@@ -25,24 +31,84 @@ type WalletIntf interface {
 	RollbackTransaction(ctx context.Context, in *wallet.RollbackTransactionReq) (*wallet.RollbackTransactionRes, error)
 	GetTransactionRecord(ctx context.Context, in *wallet.GetTransactionRecordReq) (*wallet.GetTransactionRecordRes, error)
 	GetTransactionRecords(ctx context.Context, in *wallet.GetTransactionRecordsReq) (*wallet.GetTransactionRecordsRes, error)
+	Transfer(ctx context.Context, in *wallet.TransferReq) (*wallet.TransferRes, error)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceReq) (*GetTrialBalanceRes, error)
+	GetWalletBalance(ctx context.Context, in *GetWalletBalanceReq) (*GetWalletBalanceRes, error)
+	PlaceHold(ctx context.Context, in *PlaceHoldReq) (*PlaceHoldRes, error)
+	CaptureHold(ctx context.Context, in *CaptureHoldReq) (*wallet.TransactionRes, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldReq) (*ReleaseHoldRes, error)
+	PrepareTransaction(ctx context.Context, in *wallet.PrepareTransactionReq) (*wallet.TransactionRes, error)
+	ConfirmTransaction(ctx context.Context, in *wallet.ConfirmTransactionReq) (*wallet.TransactionRes, error)
+	CancelTransaction(ctx context.Context, in *wallet.CancelTransactionReq) (*wallet.CancelTransactionRes, error)
+	RecoverPendingTransactions(ctx context.Context, in *RecoverPendingTransactionsReq) (*RecoverPendingTransactionsRes, error)
+	Reconcile(ctx context.Context, in *wallet.ReconcileReq) (*wallet.ReconcileRes, error)
+	GetReconciliationDiscrepancies(ctx context.Context, in *wallet.GetReconciliationDiscrepanciesReq) (*wallet.GetReconciliationDiscrepanciesRes, error)
+	SetInterestRates(ctx context.Context, in *SetInterestRatesReq) (*SetInterestRatesRes, error)
+	GetInterestRates(ctx context.Context, in *GetInterestRatesReq) (*GetInterestRatesRes, error)
+	AccrueInterest(ctx context.Context, in *AccrueInterestReq) (*AccrueInterestRes, error)
+	CapitalizeInterest(ctx context.Context, in *CapitalizeInterestReq) (*CapitalizeInterestRes, error)
+	CreateBonusCampaign(ctx context.Context, in *wallet.CreateBonusCampaignReq) (*wallet.CreateBonusCampaignRes, error)
+	GetBonusCampaigns(ctx context.Context, in *wallet.GetBonusCampaignsReq) (*wallet.GetBonusCampaignsRes, error)
+	GrantCampaignBonus(ctx context.Context, in *wallet.GrantCampaignBonusReq) (*wallet.TransactionRes, error)
+	GetBalanceAt(ctx context.Context, in *wallet.GetBalanceAtReq) (*wallet.GetBalanceAtRes, error)
+	TakeBalanceSnapshots(ctx context.Context, in *TakeBalanceSnapshotsReq) (*TakeBalanceSnapshotsRes, error)
+	GetBalanceSnapshots(ctx context.Context, in *wallet.GetBalanceSnapshotsReq) (*wallet.GetBalanceSnapshotsRes, error)
+	SetWalletStatus(ctx context.Context, in *wallet.SetWalletStatusReq) (*wallet.SetWalletStatusRes, error)
+	GetWalletStatusLogs(ctx context.Context, in *GetWalletStatusLogsReq) (*GetWalletStatusLogsRes, error)
+	SetTransactionLimit(ctx context.Context, in *SetTransactionLimitReq) (*SetTransactionLimitRes, error)
+	DeleteTransactionLimit(ctx context.Context, in *DeleteTransactionLimitReq) (*DeleteTransactionLimitRes, error)
+	GetTransactionLimits(ctx context.Context, in *GetTransactionLimitsReq) (*GetTransactionLimitsRes, error)
+	SetCurrency(ctx context.Context, in *SetCurrencyReq) (*SetCurrencyRes, error)
+	GetCurrencies(ctx context.Context, in *GetCurrenciesReq) (*GetCurrenciesRes, error)
+	SetCreditLimit(ctx context.Context, in *SetCreditLimitReq) (*SetCreditLimitRes, error)
+	Exchange(ctx context.Context, in *wallet.ExchangeReq) (*wallet.ExchangeRes, error)
+	GetMemberEquity(ctx context.Context, in *wallet.GetMemberEquityReq) (*wallet.GetMemberEquityRes, error)
+	ProvisionMemberWallets(ctx context.Context, in *ProvisionMemberWalletsReq) (*ProvisionMemberWalletsRes, error)
+	CloseMemberWallets(ctx context.Context, in *wallet.CloseMemberWalletsReq) (*wallet.CloseMemberWalletsRes, error)
+	SubscribeWalletEvents(in *wallet.SubscribeWalletEventsReq, stream wallet.WalletService_SubscribeWalletEventsServer) error
+	RelayOutbox(ctx context.Context, in *RelayOutboxReq) (*RelayOutboxRes, error)
+	CreateWebhook(ctx context.Context, in *wallet.CreateWebhookReq) (*wallet.CreateWebhookRes, error)
+	GetWebhooks(ctx context.Context, in *wallet.GetWebhooksReq) (*wallet.GetWebhooksRes, error)
+	SetWebhookEnabled(ctx context.Context, in *wallet.SetWebhookEnabledReq) (*wallet.SetWebhookEnabledRes, error)
+	GetWebhookDeliveries(ctx context.Context, in *wallet.GetWebhookDeliveriesReq) (*wallet.GetWebhookDeliveriesRes, error)
+	DeliverWebhooks(ctx context.Context, in *DeliverWebhooksReq) (*DeliverWebhooksRes, error)
 }
 
 type WalletImpl struct {
 	WalletClient wallet.WalletServiceClient
+	RateProvider rate.Provider
 }
 
 func New() WalletIntf {
-	return &WalletImpl{}
+	return &WalletImpl{
+		RateProvider: rate.NewRedis(func() (redis.Cmdable, error) {
+			return cache.GetRedis()
+		}),
+	}
 }
 
 func (impl *WalletImpl) CreateWallet(ctx context.Context, in *wallet.CreateWalletReq) (*wallet.CreateWalletRes, error) {
 	db := database.GetDB()
+	if err := checkMember(ctx, in.MemberID); err != nil {
+		return nil, err
+	}
+	if _, err := currency.Enabled(db, in.Currency); err != nil {
+		logging.Error(ctx, "[CreateWallet] currency %s not usable: %v", in.Currency, err)
+		return nil, err
+	}
+
 	model := &dbModels.WalletModel{
 		MemberID: in.MemberID,
 		Currency: in.Currency,
 		Amount:   decimal.Zero,
+		Status:   dbModels.WalletStatus_Active,
 	}
-	if _, err := walletDao.New(db, model); err != nil {
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		if _, err := walletDao.New(tx, model); err != nil {
+			return err
+		}
+		return writeOutbox(ctx, tx, walletEvent(dbModels.WalletEventType_WalletCreated, model))
+	}); err != nil {
 		logging.Error(ctx, "[CreateWallet] failed to new wallet: %v", err)
 		return nil, err
 	}
@@ -51,6 +117,8 @@ func (impl *WalletImpl) CreateWallet(ctx context.Context, in *wallet.CreateWalle
 	}, nil
 }
 
+// GetWallets gets a wallet or the wallets of a member. Only an admin call,
+// with AllMembers set, may leave both out and get the wallets of every member.
 func (impl *WalletImpl) GetWallets(ctx context.Context, in *wallet.GetWalletsReq) (*wallet.GetWalletsRes, error) {
 	db := database.GetDB()
 	query := &walletDao.QueryModel{}
@@ -62,12 +130,36 @@ func (impl *WalletImpl) GetWallets(ctx context.Context, in *wallet.GetWalletsReq
 		if in.Currency != nil {
 			query.Currency = in.Currency
 		}
+	default:
+		if !in.AllMembers {
+			logging.Error(ctx, "[GetWallets] missing wallet or member to get: %v", common.ErrInvalidParam)
+			return nil, common.ErrInvalidParam
+		}
+		query.Currency = in.Currency
+	}
+	if in.GetNegativeOnly() {
+		negative := true
+		query.Negative = &negative
 	}
 
-	models, err := walletDao.Gets(db, query)
+	// a single wallet is read through the cache
+	var models []dbModels.WalletModel
+	var walletModel *dbModels.WalletModel
+	var err error
+	switch {
+	case query.Negative == nil && len(query.ID) > 0:
+		walletModel, err = cachedWallet(ctx, db, query.ID[0])
+	case query.Negative == nil && len(query.MemberID) > 0 && query.Currency != nil:
+		walletModel, err = cachedMemberWallet(ctx, db, query.MemberID[0], *query.Currency)
+	default:
+		models, err = walletDao.Gets(db, query)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if walletModel != nil {
+		models = []dbModels.WalletModel{*walletModel}
+	}
 
 	wallets := []*wallet.Wallet{}
 	for _, m := range models {
@@ -78,6 +170,11 @@ func (impl *WalletImpl) GetWallets(ctx context.Context, in *wallet.GetWalletsReq
 			Currency:  m.Currency,
 			CreatedAt: m.CreatedAt.Unix(),
 			UpdatedAt: m.CreatedAt.Unix(),
+			Negative:  m.Amount.IsNegative(),
+		}
+		if m.NegativeSince.Valid {
+			negativeSince := m.NegativeSince.Time.Unix()
+			w.NegativeSince = &negativeSince
 		}
 
 		wallets = append(wallets, w)
@@ -88,16 +185,37 @@ func (impl *WalletImpl) GetWallets(ctx context.Context, in *wallet.GetWalletsReq
 	}, nil
 }
 
+// DeleteWallet soft deletes a wallet with nothing left in it. The wallets of a
+// deleted member are settled and closed by CloseMemberWallets instead.
 func (impl *WalletImpl) DeleteWallet(ctx context.Context, in *wallet.DeleteWalletReq) (*wallet.DeleteWalletRes, error) {
 	db := database.GetDB()
 	query := &walletDao.QueryModel{
 		ID: []uint64{in.Id},
 	}
 
-	if err := walletDao.Delete(db, query); err != nil {
+	walletModel, err := walletDao.Get(db, query)
+	if err != nil {
+		logging.Error(ctx, "[DeleteWallet] failed to get wallet %d: %v", in.Id, err)
+		return nil, err
+	}
+	if walletModel == nil {
+		return &wallet.DeleteWalletRes{}, nil
+	}
+	if !walletModel.Amount.IsZero() || !walletModel.HeldAmount.IsZero() {
+		logging.Error(ctx, "[DeleteWallet] wallet %d holds %s: %v", in.Id, walletModel.Amount.String(), models.ErrWalletNotSettled)
+		return nil, models.ErrWalletNotSettled
+	}
+
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		if err := walletDao.Delete(tx, query); err != nil {
+			return err
+		}
+		return writeOutbox(ctx, tx, walletEvent(dbModels.WalletEventType_WalletDeleted, walletModel))
+	}); err != nil {
 		logging.Error(ctx, "[DeleteWallet] failed to delete wallet: %v", err)
 		return nil, err
 	}
+	uncacheDeletedWallet(ctx, walletModel)
 
 	return &wallet.DeleteWalletRes{}, nil
 }
@@ -110,6 +228,47 @@ func (impl *WalletImpl) Transaction(ctx context.Context, in *wallet.TransactionR
 		logging.Error(ctx, "[Transaction] failed to cast amount to decimal: %v", err)
 		return nil, err
 	}
+	// bonuses are granted by GrantCampaignBonus, within the budget of a campaign
+	if dbModels.TransactionAction(in.Action) == dbModels.TransactionAction_Bonus {
+		logging.Error(ctx, "[Transaction] bonus outside of a campaign: %v", common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+
+	key, err := idempotencyKey(in.IdempotencyKey)
+	if err != nil {
+		logging.Error(ctx, "[Transaction] invalid idempotency key: %v", err)
+		return nil, err
+	}
+	if key != "" {
+		record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			IdempotencyKey: &key,
+		})
+		if err != nil {
+			logging.Error(ctx, "[Transaction] failed to get transaction record of idempotency key %s: %v", key, err)
+			return nil, err
+		}
+		if record != nil {
+			logging.Info(ctx, "[Transaction] idempotency key %s already used by transaction %d", key, record.ID)
+			if err := checkReplay(ctx, db, key, record, false, in.WalletID, in.Action, in.Currency, amount); err != nil {
+				return nil, err
+			}
+			return transactionRes(record), nil
+		}
+	}
+
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	var expectedAmount *decimal.Decimal
+	if in.BeforeAmount != nil {
+		before, err := decimal.NewFromString(*in.BeforeAmount)
+		if err != nil {
+			logging.Error(ctx, "[Transaction] failed to cast before amount to decimal: %v", err)
+			return nil, err
+		}
+		expectedAmount = &before
+	}
 
 	walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
 		ID: []uint64{in.WalletID},
@@ -118,6 +277,14 @@ func (impl *WalletImpl) Transaction(ctx context.Context, in *wallet.TransactionR
 		logging.Error(ctx, "[Transaction] failed to get wallet %d: %v", in.WalletID, err)
 		return nil, err
 	}
+	if walletModel == nil {
+		logging.Error(ctx, "[Transaction] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
+		return nil, common.ErrNoSuchWallet
+	}
+	amount, err = walletAmount(ctx, db, walletModel, in.Currency, amount)
+	if err != nil {
+		return nil, err
+	}
 
 	transactionRecord := &dbModels.TransactionRecordModel{
 		MemberID:    walletModel.MemberID,
@@ -136,171 +303,45 @@ func (impl *WalletImpl) Transaction(ctx context.Context, in *wallet.TransactionR
 		}
 	}
 
-	if _, err := transactionRecordDao.New(db, transactionRecord); err != nil {
-		logging.Error(ctx, "[Transaction] failed to new transaction record: %v", err)
-		return nil, err
+	if key != "" {
+		transactionRecord.IdempotencyKey = sql.NullString{
+			Valid:  true,
+			String: key,
+		}
 	}
 
-	success := false
-	retryCount := 0
-
-	beforeAmount := decimal.NewNullDecimal(decimal.Zero)
-	afterAmount := decimal.NewNullDecimal(decimal.Zero)
-
-	if in.BeforeAmount != nil {
-		func() (*wallet.TransactionRes, error) {
-			success := false
-			tx := db.Begin()
-			defer func() {
-				if !success {
-					tx.Rollback()
-					status := dbModels.TransactionStatus_Failed
-					if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-						Status: &status,
-					}); err != nil {
-						logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-					}
+	if _, err := transactionRecordDao.New(db, transactionRecord); err != nil {
+		// a concurrent retry with the same key may have won the unique index
+		if key != "" {
+			if record, _ := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+				IdempotencyKey: &key,
+			}); record != nil {
+				if err := checkReplay(ctx, db, key, record, false, in.WalletID, in.Action, in.Currency, amount); err != nil {
+					return nil, err
 				}
-			}()
-			beforeAmount.Decimal, _ = decimal.NewFromString(*in.BeforeAmount)
-			afterAmount.Decimal = beforeAmount.Decimal.Copy().Add(amount)
-			update := &walletDao.UpdateModel{
-				Amount: &afterAmount.Decimal,
-			}
-			if afterAmount.Decimal.LessThan(decimal.Zero) {
-				return nil, common.ErrInsufficientBalance
+				return transactionRes(record), nil
 			}
-			walletModel.Amount = beforeAmount.Decimal
-
-			err = walletDao.Modify(tx, walletModel, update)
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				logging.Error(ctx, "[Transaction] wallet been modified when updating %d: %v", in.WalletID, err)
-				return nil, err
-			}
-			if err != nil {
-				logging.Error(ctx, "[Transaction] wallet [%d] Modify failed: %v", in.WalletID, err)
-				return nil, err
-			}
-
-			status := dbModels.TransactionStatus_Success
-			if err := transactionRecordDao.Modify(tx, transactionRecord, &transactionRecordDao.UpdateModel{
-				BeforeAmount: &beforeAmount,
-				AfterAmount:  &afterAmount,
-				Status:       &status,
-			}); err != nil {
-				logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-				return nil, err
-			}
-			tx.Commit()
-			success = true
-
-			transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
-				ID: &transactionRecord.ID,
-			})
-			if err != nil {
-				logging.Error(ctx, "[Transaction] failed to get modified transaction record: %v", err)
-				return nil, err
-			}
-
-			return &wallet.TransactionRes{
-				Id:           transactionRecord.ID,
-				BeforeAmount: beforeAmount.Decimal.String(),
-				AfterAmount:  afterAmount.Decimal.String(),
-				Currency:     in.Currency,
-				Status:       wallet.Status(dbModels.TransactionStatus_Success),
-				CreatedAt:    transactionRecord.CreatedAt.Unix(),
-				UpdatedAt:    transactionRecord.UpdatedAt.Unix(),
-			}, nil
-		}()
-	}
-
-	db = db.Begin()
-	for !success {
-		if retryCount > 10 {
-			logging.Error(ctx, "[Transaction] failed to transaction %d: %v", in.WalletID, common.ErrUpdateWalletInterrupted)
-
-			db = db.Rollback()
-			status := dbModels.TransactionStatus_Failed
-			if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-				Status: &status,
-			}); err != nil {
-				logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-			}
-			return nil, common.ErrUpdateWalletInterrupted
-		}
-		retryCount++
-
-		walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
-			ID: []uint64{in.WalletID},
-		})
-		if err != nil {
-			logging.Error(ctx, "[Transaction] failed to get wallet %d: %v", in.WalletID, err)
-
-			db = db.Rollback()
-			status := dbModels.TransactionStatus_Failed
-			if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-				Status: &status,
-			}); err != nil {
-				logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-			}
-			return nil, err
-		}
-		if walletModel == nil {
-			logging.Error(ctx, "[Transaction] no such wallet %d: %v", in.WalletID, common.ErrNoSuchWallet)
-
-			db = db.Rollback()
-			status := dbModels.TransactionStatus_Failed
-			if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-				Status: &status,
-			}); err != nil {
-				logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-			}
-			return nil, common.ErrNoSuchWallet
-		}
-
-		beforeAmount.Decimal = walletModel.Amount
-		afterAmount.Decimal = walletModel.Amount.Copy().Add(amount)
-		update := &walletDao.UpdateModel{
-			Amount: &afterAmount.Decimal,
-		}
-
-		if afterAmount.Decimal.LessThan(decimal.Zero) {
-			return nil, common.ErrInsufficientBalance
 		}
-
-		err = walletDao.Modify(db, walletModel, update)
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logging.Debug(ctx, "[Transaction] wallet been modified when updating %d: %v", in.WalletID, err)
-			continue
-		}
-		if err != nil {
-			logging.Error(ctx, "[Transaction] failed to update wallet %d: %v", in.WalletID, err)
-
-			db = db.Rollback()
-			status := dbModels.TransactionStatus_Failed
-			if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-				Status: &status,
-			}); err != nil {
-				logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-			}
-			return nil, err
-		}
-
-		success = true
+		logging.Error(ctx, "[Transaction] failed to new transaction record: %v", err)
+		return nil, err
 	}
 
-	status := dbModels.TransactionStatus_Success
-	if err := transactionRecordDao.Modify(db, transactionRecord, &transactionRecordDao.UpdateModel{
-		BeforeAmount: &beforeAmount,
-		AfterAmount:  &afterAmount,
-		Status:       &status,
-	}); err != nil {
-		db = db.Rollback()
-		logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
+	records := []*dbModels.TransactionRecordModel{transactionRecord}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return applyRecords(ctx, tx, records, func(_ *dbModels.TransactionRecordModel, walletModel *dbModels.WalletModel, _ *walletDao.UpdateModel) error {
+			// the caller expects the wallet to hold exactly this amount
+			if expectedAmount != nil && !walletModel.Amount.Equal(*expectedAmount) {
+				return common.ErrUpdateWalletInterrupted
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		logging.Error(ctx, "[Transaction] failed to apply transaction %d: %v", transactionRecord.ID, err)
+		failRecords(ctx, db, records)
 		return nil, err
 	}
-	db = db.Commit()
-	db = database.GetDB()
+	refreshWalletCache(ctx, db, transactionRecord.WalletID)
 
 	transactionRecord, err = transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
 		ID: &transactionRecord.ID,
@@ -310,21 +351,50 @@ func (impl *WalletImpl) Transaction(ctx context.Context, in *wallet.TransactionR
 		return nil, err
 	}
 
-	return &wallet.TransactionRes{
-		Id:           transactionRecord.ID,
-		BeforeAmount: beforeAmount.Decimal.String(),
-		AfterAmount:  afterAmount.Decimal.String(),
-		Currency:     in.Currency,
-		Status:       wallet.Status(dbModels.TransactionStatus_Success),
-		CreatedAt:    transactionRecord.CreatedAt.Unix(),
-		UpdatedAt:    transactionRecord.UpdatedAt.Unix(),
-	}, nil
+	return transactionRes(transactionRecord), nil
 }
 
 func (impl *WalletImpl) RollbackTransaction(ctx context.Context, in *wallet.RollbackTransactionReq) (*wallet.RollbackTransactionRes, error) {
 
 	db := database.GetDB()
 
+	key, err := idempotencyKey(in.IdempotencyKey)
+	if err != nil {
+		logging.Error(ctx, "[RollbackTransaction] invalid idempotency key: %v", err)
+		return nil, err
+	}
+	rolledBack := func() (bool, error) {
+		if key == "" {
+			return false, nil
+		}
+		record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
+			RollbackIdempotencyKey: &key,
+		})
+		if err != nil {
+			logging.Error(ctx, "[RollbackTransaction] failed to get transaction record of idempotency key %s: %v", key, err)
+			return false, err
+		}
+		if record == nil {
+			return false, nil
+		}
+		if record.ID != in.Id || record.RollbackerID.Int64 != int64(in.RollbackerID) {
+			logging.Error(ctx, "[RollbackTransaction] idempotency key %s of transaction %d replayed by another request: %v", key, record.ID, models.ErrIdempotencyKeyConflict)
+			return false, models.ErrIdempotencyKeyConflict
+		}
+		logging.Info(ctx, "[RollbackTransaction] idempotency key %s already rolled back transaction %d", key, in.Id)
+		return true, nil
+	}
+	done, err := rolledBack()
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		return &wallet.RollbackTransactionRes{}, nil
+	}
+	if err := checkCommitter(ctx, in.RollbackerID); err != nil {
+		return nil, err
+	}
+
 	record, err := transactionRecordDao.Get(db, &transactionRecordDao.QueryModel{
 		ID: &in.Id,
 	})
@@ -336,57 +406,23 @@ func (impl *WalletImpl) RollbackTransaction(ctx context.Context, in *wallet.Roll
 		logging.Error(ctx, "[RollbackTransaction] no such transaction record: %v", common.ErrNoSuchTransactionRecord)
 		return nil, common.ErrNoSuchTransactionRecord
 	}
-	if record.Status != dbModels.TransactionStatus_Success {
-		logging.Error(ctx, "[RollbackTransaction] this transaction is not successful: %v", common.ErrTransactionNotSuccess)
-		return nil, common.ErrTransactionNotSuccess
-	}
-
-	success := false
-	retryCount := 0
-
-	beforeAmount := decimal.NewNullDecimal(decimal.Zero)
-	afterAmount := decimal.NewNullDecimal(decimal.Zero)
 
-	db = db.Begin()
-	for !success {
-		if retryCount > 10 {
-			logging.Error(ctx, "[RollbackTransaction] failed to transaction %d: %v", record.WalletID, common.ErrUpdateWalletInterrupted)
-			return nil, common.ErrUpdateWalletInterrupted
-		}
-		retryCount++
-
-		walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
-			ID: []uint64{record.WalletID},
+	// both legs of a transfer are rolled back together
+	records := []dbModels.TransactionRecordModel{*record}
+	if record.TransferID.Valid {
+		records, err = transactionRecordDao.Gets(db, &transactionRecordDao.QueryModel{
+			TransferID: &record.TransferID.String,
 		})
 		if err != nil {
-			logging.Error(ctx, "[RollbackTransaction] failed to get wallet %d: %v", record.WalletID, err)
-			db = db.Rollback()
+			logging.Error(ctx, "[RollbackTransaction] failed to get transfer %s records: %v", record.TransferID.String, err)
 			return nil, err
 		}
-		if walletModel == nil {
-			logging.Error(ctx, "[RollbackTransaction] no such wallet %d: %v", record.WalletID, common.ErrNoSuchWallet)
-			db = db.Rollback()
-			return nil, common.ErrNoSuchWallet
-		}
-
-		beforeAmount.Decimal = walletModel.Amount
-		afterAmount.Decimal = walletModel.Amount.Add(record.Amount.Neg())
-		update := &walletDao.UpdateModel{
-			Amount: &afterAmount.Decimal,
-		}
-
-		err = walletDao.Modify(db, walletModel, update)
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logging.Debug(ctx, "[RollbackTransaction] wallet been modified when updating %d: %v", record.WalletID, err)
-			continue
-		}
-		if err != nil {
-			logging.Error(ctx, "[RollbackTransaction] failed to update wallet %d: %v", record.WalletID, err)
-			db = db.Rollback()
-			return nil, err
+	}
+	for _, r := range records {
+		if r.Status != dbModels.TransactionStatus_Success {
+			logging.Error(ctx, "[RollbackTransaction] transaction %d is not successful: %v", r.ID, common.ErrTransactionNotSuccess)
+			return nil, common.ErrTransactionNotSuccess
 		}
-
-		success = true
 	}
 
 	status := dbModels.TransactionStatus_Rollback
@@ -402,18 +438,81 @@ func (impl *WalletImpl) RollbackTransaction(ctx context.Context, in *wallet.Roll
 	} else {
 		remark = nil
 	}
-	if err := transactionRecordDao.Modify(db, record, &transactionRecordDao.UpdateModel{
-		RollbackBeforeAmount: &beforeAmount,
-		RollbackAfterAmount:  &afterAmount,
-		Status:               &status,
-		RollbackerID:         &rollbackerID,
-		Remark:               remark,
-	}); err != nil {
-		logging.Error(ctx, "[Transaction] failed to modify transaction record: %v", err)
-		db = db.Rollback()
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		legs := make([]*dbModels.TransactionRecordModel, 0, len(records))
+		for i := range records {
+			r := &records[i]
+			beforeAmount := decimal.NewNullDecimal(decimal.Zero)
+			afterAmount := decimal.NewNullDecimal(decimal.Zero)
+
+			walletModel, err := updateWallet(ctx, tx, r.WalletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+				// rolling back moves the amount out again, so the wallet must
+				// allow the opposite of the record
+				if err := checkWalletStatus(walletModel, r.Amount.Neg()); err != nil {
+					return nil, err
+				}
+				beforeAmount.Decimal = walletModel.Amount
+				afterAmount.Decimal = walletModel.Amount.Sub(r.Amount)
+				return &walletDao.UpdateModel{
+					Amount: &afterAmount.Decimal,
+				}, nil
+			})
+			if err != nil {
+				logging.Error(ctx, "[RollbackTransaction] failed to update wallet %d: %v", r.WalletID, err)
+				return err
+			}
+
+			rolledBackAt := time.Now()
+			update := &transactionRecordDao.UpdateModel{
+				RollbackBeforeAmount: &beforeAmount,
+				RollbackAfterAmount:  &afterAmount,
+				Status:               &status,
+				RollbackerID:         &rollbackerID,
+				Remark:               remark,
+				RollbackWalletVersion: &sql.NullInt64{
+					Valid: true,
+					Int64: int64(walletModel.Version),
+				},
+				RolledBackAt: &sql.NullTime{
+					Valid: true,
+					Time:  rolledBackAt,
+				},
+			}
+			if key != "" && r.ID == record.ID {
+				update.RollbackIdempotencyKey = &sql.NullString{
+					Valid:  true,
+					String: key,
+				}
+			}
+			if err := transactionRecordDao.Modify(tx, r, update); err != nil {
+				logging.Error(ctx, "[RollbackTransaction] failed to modify transaction record %d: %v", r.ID, err)
+				return err
+			}
+			if err := writeOutbox(ctx, tx, recordEvent(dbModels.WalletEventType_Rollback, r, beforeAmount.Decimal, afterAmount.Decimal, walletModel.Version, rolledBackAt)); err != nil {
+				return err
+			}
+			legs = append(legs, r)
+		}
+		return ledger.Reverse(ctx, tx, ledger.RollbackReference(record), legs)
+	})
+	if err != nil {
+		// a concurrent retry with the same key may have done it
+		done, replayErr := rolledBack()
+		if replayErr != nil {
+			return nil, replayErr
+		}
+		if done {
+			return &wallet.RollbackTransactionRes{}, nil
+		}
 		return nil, err
 	}
-	db = db.Commit()
+
+	walletID := make([]uint64, 0, len(records))
+	for _, r := range records {
+		walletID = append(walletID, r.WalletID)
+	}
+	refreshWalletCache(ctx, db, walletID...)
 
 	return &wallet.RollbackTransactionRes{}, nil
 }
@@ -564,3 +663,175 @@ func (impl *WalletImpl) GetTransactionRecords(ctx context.Context, in *wallet.Ge
 	}
 	return res, nil
 }
+
+// updateWallet reads the wallet inside tx and writes back the update returned
+// by mutate. The write only succeeds if the wallet has not changed since it was
+// read. Under the optimistic lock strategy a failed write retries the whole
+// read-mutate-write after a backoff, under the pessimistic one the read locks
+// the wallet so the write can not fail. It returns the wallet as updated.
+func updateWallet(ctx context.Context, tx *gorm.DB, walletID uint64, mutate func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error)) (*dbModels.WalletModel, error) {
+	forUpdate := lockStrategy == LockStrategy_Pessimistic
+	db := tx
+	for retryCount := 0; ; retryCount++ {
+		if retryCount > 0 {
+			if forUpdate || retryCount > maxUpdateWalletRetries {
+				return nil, common.ErrUpdateWalletInterrupted
+			}
+			if err := sleepContext(ctx, updateWalletBackoff(retryCount)); err != nil {
+				return nil, err
+			}
+			// the snapshot tx reads from may be older than the change that
+			// beat the write, read what is committed now. tx has not written
+			// the wallet yet, or it would hold the row and the write would not
+			// have failed.
+			db = database.GetDB()
+		}
+
+		walletModel, err := walletDao.Get(db, &walletDao.QueryModel{
+			ID:        []uint64{walletID},
+			ForUpdate: forUpdate,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if walletModel == nil {
+			return nil, common.ErrNoSuchWallet
+		}
+
+		update, err := mutate(walletModel)
+		if err != nil {
+			return nil, err
+		}
+		// nothing changes
+		if (update.Amount == nil || update.Amount.Equal(walletModel.Amount)) &&
+			(update.HeldAmount == nil || update.HeldAmount.Equal(walletModel.HeldAmount)) &&
+			(update.Status == nil || *update.Status == walletModel.Status) &&
+			(update.CreditLimit == nil || update.CreditLimit.Equal(walletModel.CreditLimit)) {
+			return walletModel, nil
+		}
+		// flag the wallet from when it goes below zero until it is back
+		if update.Amount != nil && update.Amount.IsNegative() != walletModel.NegativeSince.Valid {
+			update.NegativeSince = &sql.NullTime{
+				Valid: update.Amount.IsNegative(),
+				Time:  time.Now(),
+			}
+		}
+
+		err = walletDao.Modify(tx, walletModel, update)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logging.Debug(ctx, "[updateWallet] wallet been modified when updating %d: %v", walletID, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if update.Amount != nil {
+			walletModel.Amount = *update.Amount
+		}
+		if update.HeldAmount != nil {
+			walletModel.HeldAmount = *update.HeldAmount
+		}
+		if update.Status != nil {
+			walletModel.Status = *update.Status
+		}
+		if update.CreditLimit != nil {
+			walletModel.CreditLimit = *update.CreditLimit
+		}
+		if update.NegativeSince != nil {
+			walletModel.NegativeSince = *update.NegativeSince
+		}
+		walletModel.Version++
+		return walletModel, nil
+	}
+}
+
+// applyRecords moves the amount of each pending record into its wallet and
+// marks the record successful, then journals the records as one entry. adjust
+// may validate or change the wallet update of a record before it is written.
+// A debit never takes the amount not held below the negative credit limit.
+func applyRecords(ctx context.Context, tx *gorm.DB, records []*dbModels.TransactionRecordModel, adjust func(record *dbModels.TransactionRecordModel, walletModel *dbModels.WalletModel, update *walletDao.UpdateModel) error) error {
+	for _, record := range records {
+		beforeAmount := decimal.NewNullDecimal(decimal.Zero)
+		afterAmount := decimal.NewNullDecimal(decimal.Zero)
+
+		walletModel, err := updateWallet(ctx, tx, record.WalletID, func(walletModel *dbModels.WalletModel) (*walletDao.UpdateModel, error) {
+			if err := checkWalletStatus(walletModel, record.Amount); err != nil {
+				return nil, err
+			}
+			beforeAmount.Decimal = walletModel.Amount
+			afterAmount.Decimal = walletModel.Amount.Add(record.Amount)
+			heldAmount := walletModel.HeldAmount
+			update := &walletDao.UpdateModel{
+				Amount:     &afterAmount.Decimal,
+				HeldAmount: &heldAmount,
+			}
+			if adjust != nil {
+				if err := adjust(record, walletModel, update); err != nil {
+					return nil, err
+				}
+			}
+			if record.Amount.IsNegative() && update.Amount.Sub(*update.HeldAmount).LessThan(walletModel.CreditLimit.Neg()) {
+				return nil, common.ErrInsufficientBalance
+			}
+			return update, nil
+		})
+		if err != nil {
+			logging.Error(ctx, "[applyRecords] failed to update wallet %d: %v", record.WalletID, err)
+			return err
+		}
+		// tx holds the wallet now, so concurrent records of the wallet are
+		// checked one after another
+		if err := checkLimits(ctx, tx, record); err != nil {
+			return err
+		}
+
+		status := dbModels.TransactionStatus_Success
+		appliedAt := time.Now()
+		if err := transactionRecordDao.Modify(tx, record, &transactionRecordDao.UpdateModel{
+			BeforeAmount: &beforeAmount,
+			AfterAmount:  &afterAmount,
+			Status:       &status,
+			WalletVersion: &sql.NullInt64{
+				Valid: true,
+				Int64: int64(walletModel.Version),
+			},
+			AppliedAt: &sql.NullTime{
+				Valid: true,
+				Time:  appliedAt,
+			},
+		}); err != nil {
+			logging.Error(ctx, "[applyRecords] failed to modify transaction record %d: %v", record.ID, err)
+			return err
+		}
+		if err := writeOutbox(ctx, tx, recordEvent(dbModels.WalletEventType_Transaction, record, beforeAmount.Decimal, afterAmount.Decimal, walletModel.Version, appliedAt)); err != nil {
+			return err
+		}
+	}
+
+	return ledger.Post(ctx, tx, ledger.TransactionReference(records[0]), records)
+}
+
+// failRecords marks pending records failed and releases their idempotency keys
+// so that the client can retry with them. It returns the records it failed,
+// leaving out those no longer pending or failing to be written.
+func failRecords(ctx context.Context, db *gorm.DB, records []*dbModels.TransactionRecordModel) []*dbModels.TransactionRecordModel {
+	status := dbModels.TransactionStatus_Failed
+	failed := make([]*dbModels.TransactionRecordModel, 0, len(records))
+	for _, record := range records {
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			if err := transactionRecordDao.Modify(tx, record, &transactionRecordDao.UpdateModel{
+				Status:         &status,
+				IdempotencyKey: &sql.NullString{},
+			}); err != nil {
+				return err
+			}
+			return writeOutbox(ctx, tx, failedEvent(record))
+		}); err != nil {
+			logging.Error(ctx, "[failRecords] failed to modify transaction record %d: %v", record.ID, err)
+			continue
+		}
+		failed = append(failed, record)
+	}
+	return failed
+}
@@ -0,0 +1,445 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+
+	common "github.com/paper-trade-chatbot/be-common"
+	"github.com/paper-trade-chatbot/be-common/database"
+	"github.com/paper-trade-chatbot/be-common/logging"
+	"github.com/paper-trade-chatbot/be-proto/wallet"
+	"github.com/paper-trade-chatbot/be-wallet/dao/webhookDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/webhookDeliveryAttemptDao"
+	"github.com/paper-trade-chatbot/be-wallet/dao/webhookDeliveryDao"
+	"github.com/paper-trade-chatbot/be-wallet/models"
+	"github.com/paper-trade-chatbot/be-wallet/models/dbModels"
+	"github.com/paper-trade-chatbot/be-wallet/service/webhook"
+	"gorm.io/gorm"
+)
+
+const (
+	maxWebhookURLLength = 255
+	deliverBatchSize    = 100
+	// deliveries sent at the same time, so that a slow receiver does not hold
+	// up the others
+	deliverConcurrency  = 10
+	maxAttemptErrLength = 255
+	// only one deliverer runs at a time, so that no delivery is sent twice.
+	// The lock is extended before each batch, and outlasts a batch sent in
+	// deliverBatchSize / deliverConcurrency rounds of the client timeout.
+	deliverLockKey    = "webhook:deliver"
+	deliverLockExpiry = 2 * time.Minute
+)
+
+type DeliverWebhooksReq struct{}
+
+type DeliverWebhooksRes struct {
+	Delivered int
+	Retrying  int
+	Failed    int
+}
+
+// CreateWebhook calls the URL on every event of EventType, narrowed down to the
+// transactions of Action unless it is zero. The URL must resolve to public
+// addresses only. The secret the deliveries are signed with is given out only
+// here.
+func (impl *WalletImpl) CreateWebhook(ctx context.Context, in *wallet.CreateWebhookReq) (*wallet.CreateWebhookRes, error) {
+
+	db := database.GetDB()
+	u, err := url.ParseRequestURI(in.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(in.Url) > maxWebhookURLLength {
+		logging.Error(ctx, "[CreateWebhook] invalid url %s: %v", in.Url, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := webhook.CheckHost(ctx, u.Hostname()); err != nil {
+		logging.Error(ctx, "[CreateWebhook] url %s not public: %v", in.Url, err)
+		return nil, models.ErrWebhookNotPublic
+	}
+	eventType := dbModels.WalletEventType(in.EventType)
+	if eventType < dbModels.WalletEventType_Transaction || eventType > dbModels.WalletEventType_WalletDeleted ||
+		(in.Action != 0 && eventType >= dbModels.WalletEventType_WalletCreated) {
+		logging.Error(ctx, "[CreateWebhook] invalid event type %d of action %d: %v", in.EventType, in.Action, common.ErrInvalidParam)
+		return nil, common.ErrInvalidParam
+	}
+	if err := checkCommitter(ctx, in.CommitterID); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		logging.Error(ctx, "[CreateWebhook] failed to generate secret: %v", err)
+		return nil, err
+	}
+	model := &dbModels.WebhookModel{
+		URL:         in.Url,
+		EventType:   eventType,
+		Action:      dbModels.TransactionAction(in.Action),
+		Secret:      secret,
+		Enabled:     true,
+		CommitterID: in.CommitterID,
+	}
+	if _, err := webhookDao.New(db, model); err != nil {
+		logging.Error(ctx, "[CreateWebhook] failed to new webhook: %v", err)
+		return nil, err
+	}
+
+	return &wallet.CreateWebhookRes{
+		Id:     model.ID,
+		Secret: secret,
+	}, nil
+}
+
+func (impl *WalletImpl) GetWebhooks(ctx context.Context, in *wallet.GetWebhooksReq) (*wallet.GetWebhooksRes, error) {
+
+	db := database.GetDB()
+	query := &webhookDao.QueryModel{
+		Enabled: in.Enabled,
+	}
+	if in.Id != nil {
+		query.ID = []uint64{*in.Id}
+	}
+	if in.EventType != nil {
+		eventType := dbModels.WalletEventType(*in.EventType)
+		query.EventType = &eventType
+	}
+
+	models, err := webhookDao.Gets(db, query)
+	if err != nil {
+		logging.Error(ctx, "[GetWebhooks] failed to get webhooks: %v", err)
+		return nil, err
+	}
+
+	res := &wallet.GetWebhooksRes{}
+	for _, m := range models {
+		res.Webhooks = append(res.Webhooks, &wallet.Webhook{
+			Id:          m.ID,
+			Url:         m.URL,
+			EventType:   wallet.WalletEventType(m.EventType),
+			Action:      wallet.Action(m.Action),
+			Enabled:     m.Enabled,
+			CommitterID: m.CommitterID,
+			CreatedAt:   m.CreatedAt.Unix(),
+		})
+	}
+	return res, nil
+}
+
+// SetWebhookEnabled enables or disables a webhook. The pending deliveries of a
+// disabled webhook fail when they are next due.
+func (impl *WalletImpl) SetWebhookEnabled(ctx context.Context, in *wallet.SetWebhookEnabledReq) (*wallet.SetWebhookEnabledRes, error) {
+
+	db := database.GetDB()
+	model, err := webhookDao.Get(db, &webhookDao.QueryModel{
+		ID: []uint64{in.Id},
+	})
+	if err != nil {
+		logging.Error(ctx, "[SetWebhookEnabled] failed to get webhook %d: %v", in.Id, err)
+		return nil, err
+	}
+	if model == nil {
+		logging.Error(ctx, "[SetWebhookEnabled] no webhook %d: %v", in.Id, models.ErrNoSuchWebhook)
+		return nil, models.ErrNoSuchWebhook
+	}
+
+	if err := webhookDao.Modify(db, model, &webhookDao.UpdateModel{
+		Enabled: &in.Enabled,
+	}); err != nil {
+		logging.Error(ctx, "[SetWebhookEnabled] failed to modify webhook %d: %v", in.Id, err)
+		return nil, err
+	}
+	return &wallet.SetWebhookEnabledRes{}, nil
+}
+
+// GetWebhookDeliveries returns the deliveries, newest first, each with its
+// attempts in the order they were made.
+func (impl *WalletImpl) GetWebhookDeliveries(ctx context.Context, in *wallet.GetWebhookDeliveriesReq) (*wallet.GetWebhookDeliveriesRes, error) {
+
+	db := database.GetDB()
+	status := make([]dbModels.WebhookDeliveryStatus, 0, len(in.Status))
+	for _, s := range in.Status {
+		status = append(status, dbModels.WebhookDeliveryStatus(s))
+	}
+	deliveries, paginationInfo, err := webhookDeliveryDao.GetsWithPagination(db, &webhookDeliveryDao.QueryModel{
+		ID:        in.DeliveryID,
+		WebhookID: in.WebhookID,
+		Status:    status,
+	}, in.Pagination)
+	if err != nil {
+		logging.Error(ctx, "[GetWebhookDeliveries] failed to get deliveries: %v", err)
+		return nil, err
+	}
+
+	res := &wallet.GetWebhookDeliveriesRes{
+		PaginationInfo: paginationInfo,
+	}
+	if len(deliveries) == 0 {
+		return res, nil
+	}
+
+	deliveryID := make([]uint64, 0, len(deliveries))
+	for _, d := range deliveries {
+		deliveryID = append(deliveryID, d.ID)
+	}
+	attempts, err := webhookDeliveryAttemptDao.Gets(db, &webhookDeliveryAttemptDao.QueryModel{
+		DeliveryID: deliveryID,
+	})
+	if err != nil {
+		logging.Error(ctx, "[GetWebhookDeliveries] failed to get delivery attempts: %v", err)
+		return nil, err
+	}
+	attemptsOf := map[uint64][]*wallet.WebhookDeliveryAttempt{}
+	for _, a := range attempts {
+		attempt := &wallet.WebhookDeliveryAttempt{
+			Attempt:    int32(a.Attempt),
+			DurationMs: a.DurationMs,
+			CreatedAt:  a.CreatedAt.Unix(),
+		}
+		if a.StatusCode.Valid {
+			statusCode := a.StatusCode.Int32
+			attempt.StatusCode = &statusCode
+		}
+		if a.Error.Valid {
+			message := a.Error.String
+			attempt.Error = &message
+		}
+		attemptsOf[a.DeliveryID] = append(attemptsOf[a.DeliveryID], attempt)
+	}
+
+	for _, d := range deliveries {
+		delivery := &wallet.WebhookDelivery{
+			Id:            d.ID,
+			WebhookID:     d.WebhookID,
+			OutboxID:      d.OutboxID,
+			EventType:     wallet.WalletEventType(d.EventType),
+			Payload:       d.Payload,
+			Status:        wallet.WebhookDeliveryStatus(d.Status),
+			NextAttemptAt: d.NextAttemptAt.Unix(),
+			CreatedAt:     d.CreatedAt.Unix(),
+			Attempts:      attemptsOf[d.ID],
+		}
+		if d.DeliveredAt.Valid {
+			deliveredAt := d.DeliveredAt.Time.Unix()
+			delivery.DeliveredAt = &deliveredAt
+		}
+		res.Deliveries = append(res.Deliveries, delivery)
+	}
+	return res, nil
+}
+
+// DeliverWebhooks sends the deliveries that are due. A delivery that is not
+// answered with 2xx is retried with exponential backoff until it runs out of
+// attempts.
+func (impl *WalletImpl) DeliverWebhooks(ctx context.Context, in *DeliverWebhooksReq) (*DeliverWebhooksRes, error) {
+
+	db := database.GetDB()
+	res := &DeliverWebhooksRes{}
+
+	lock, err := lockJob(ctx, deliverLockKey, deliverLockExpiry)
+	if err != nil {
+		logging.Error(ctx, "[DeliverWebhooks] failed to lock deliverer: %v", err)
+		return nil, err
+	}
+	if lock == nil {
+		return res, nil
+	}
+	defer lock.unlock(ctx)
+
+	for ctx.Err() == nil {
+		// a deliverer that lost the lock would send what the next one sends
+		if locked, err := lock.extend(ctx); err != nil || !locked {
+			logging.Error(ctx, "[DeliverWebhooks] lost deliverer lock: %v", err)
+			return res, err
+		}
+
+		now := time.Now()
+		deliveries, err := webhookDeliveryDao.Gets(db, &webhookDeliveryDao.QueryModel{
+			Status: []dbModels.WebhookDeliveryStatus{dbModels.WebhookDeliveryStatus_Pending},
+			DueAt:  &now,
+			Limit:  deliverBatchSize,
+		})
+		if err != nil {
+			logging.Error(ctx, "[DeliverWebhooks] failed to get due deliveries: %v", err)
+			return res, err
+		}
+		if len(deliveries) == 0 {
+			return res, nil
+		}
+
+		webhookID := make([]uint64, 0, len(deliveries))
+		for _, d := range deliveries {
+			webhookID = append(webhookID, d.WebhookID)
+		}
+		webhooks, err := webhookDao.Gets(db, &webhookDao.QueryModel{
+			ID: webhookID,
+		})
+		if err != nil {
+			logging.Error(ctx, "[DeliverWebhooks] failed to get webhooks: %v", err)
+			return res, err
+		}
+		webhookOf := map[uint64]*dbModels.WebhookModel{}
+		for i := range webhooks {
+			webhookOf[webhooks[i].ID] = &webhooks[i]
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, deliverConcurrency)
+		for i := range deliveries {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(d *dbModels.WebhookDeliveryModel) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				status, err := deliverWebhook(ctx, db, d, webhookOf[d.WebhookID])
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				switch status {
+				case dbModels.WebhookDeliveryStatus_Delivered:
+					res.Delivered++
+				case dbModels.WebhookDeliveryStatus_Pending:
+					res.Retrying++
+				case dbModels.WebhookDeliveryStatus_Failed:
+					res.Failed++
+				}
+			}(&deliveries[i])
+		}
+		wg.Wait()
+
+		if len(deliveries) < deliverBatchSize {
+			return res, nil
+		}
+	}
+
+	return res, ctx.Err()
+}
+
+// deliverWebhook sends the delivery once and records the attempt, returning
+// the status the delivery is left in.
+func deliverWebhook(ctx context.Context, db *gorm.DB, d *dbModels.WebhookDeliveryModel, w *dbModels.WebhookModel) (dbModels.WebhookDeliveryStatus, error) {
+
+	now := time.Now()
+	if w == nil || !w.Enabled {
+		status := dbModels.WebhookDeliveryStatus_Failed
+		if err := webhookDeliveryDao.Modify(db, d, &webhookDeliveryDao.UpdateModel{
+			Status: &status,
+		}); err != nil {
+			logging.Error(ctx, "[deliverWebhook] failed to fail delivery %d of disabled webhook %d: %v", d.ID, d.WebhookID, err)
+			return d.Status, err
+		}
+		return status, nil
+	}
+
+	statusCode, sendErr := webhook.Send(ctx, &webhook.Delivery{
+		ID:        d.ID,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		EventType: int(d.EventType),
+		Payload:   []byte(d.Payload),
+	})
+	finishedAt := time.Now()
+
+	attempt := &dbModels.WebhookDeliveryAttemptModel{
+		DeliveryID: d.ID,
+		Attempt:    d.Attempts + 1,
+		DurationMs: finishedAt.Sub(now).Milliseconds(),
+	}
+	if statusCode != 0 {
+		attempt.StatusCode = sql.NullInt32{
+			Valid: true,
+			Int32: int32(statusCode),
+		}
+	}
+	update := &webhookDeliveryDao.UpdateModel{
+		Attempts: &attempt.Attempt,
+	}
+	status := dbModels.WebhookDeliveryStatus_Delivered
+	if sendErr != nil {
+		message := sendErr.Error()
+		if len(message) > maxAttemptErrLength {
+			message = message[:maxAttemptErrLength]
+		}
+		attempt.Error = sql.NullString{
+			Valid:  true,
+			String: message,
+		}
+		status = dbModels.WebhookDeliveryStatus_Failed
+		if retry, ok := webhook.Retry(attempt.Attempt); ok {
+			status = dbModels.WebhookDeliveryStatus_Pending
+			nextAttemptAt := finishedAt.Add(retry)
+			update.NextAttemptAt = &nextAttemptAt
+		}
+		logging.Warn(ctx, "[deliverWebhook] attempt %d of delivery %d failed: %v", attempt.Attempt, d.ID, sendErr)
+	} else {
+		update.DeliveredAt = &finishedAt
+	}
+	update.Status = &status
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if _, err := webhookDeliveryAttemptDao.New(tx, attempt); err != nil {
+			return err
+		}
+		return webhookDeliveryDao.Modify(tx, d, update)
+	})
+	if err != nil {
+		logging.Error(ctx, "[deliverWebhook] failed to record attempt %d of delivery %d: %v", attempt.Attempt, d.ID, err)
+		return d.Status, err
+	}
+	return status, nil
+}
+
+// queueWebhookDeliveries queues a delivery of each event to each enabled
+// webhook of its type. An event queued again, being relayed twice, is ignored.
+func queueWebhookDeliveries(ctx context.Context, tx *gorm.DB, events []dbModels.OutboxModel) error {
+	enabled := true
+	webhooks, err := webhookDao.Gets(tx, &webhookDao.QueryModel{
+		Enabled: &enabled,
+	})
+	if err != nil {
+		logging.Error(ctx, "[queueWebhookDeliveries] failed to get webhooks: %v", err)
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	deliveries := make([]*dbModels.WebhookDeliveryModel, 0)
+	for _, e := range events {
+		event := &eventPayload{}
+		if err := json.Unmarshal([]byte(e.Payload), event); err != nil {
+			logging.Warn(ctx, "[queueWebhookDeliveries] failed to unmarshal outbox event %d: %v", e.ID, err)
+			continue
+		}
+		for _, w := range webhooks {
+			if w.EventType != e.EventType || (w.Action != 0 && w.Action != dbModels.TransactionAction(event.Action)) {
+				continue
+			}
+			deliveries = append(deliveries, &dbModels.WebhookDeliveryModel{
+				WebhookID:     w.ID,
+				OutboxID:      e.ID,
+				EventType:     e.EventType,
+				Payload:       e.Payload,
+				Status:        dbModels.WebhookDeliveryStatus_Pending,
+				NextAttemptAt: now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if _, err := webhookDeliveryDao.NewsIfNotExist(tx, deliveries); err != nil {
+		logging.Error(ctx, "[queueWebhookDeliveries] failed to new deliveries: %v", err)
+		return err
+	}
+	return nil
+}
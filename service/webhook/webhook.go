@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// The headers of a delivery. The signature is "sha256=" followed by the hex
+// HMAC-SHA256 of the timestamp, a dot and the body, keyed by the webhook
+// secret. Receivers recompute it and reject old timestamps to stop replays.
+const (
+	HeaderSignature = "X-Wallet-Signature"
+	HeaderTimestamp = "X-Wallet-Timestamp"
+	HeaderDelivery  = "X-Wallet-Delivery"
+	HeaderEvent     = "X-Wallet-Event"
+)
+
+const (
+	// a delivery is retried after 30s, 1m, 2m... up to maxAttempts attempts in
+	// about eight and a half hours, then it fails
+	retryBase   = 30 * time.Second
+	maxAttempts = 11
+
+	timeout = 10 * time.Second
+	// the response is not used, only read to reuse the connection
+	maxResponseSize = 64 * 1024
+)
+
+// ErrNotPublic is returned for a webhook on an address that is not public, as
+// webhooks must not reach into the network of the service.
+var ErrNotPublic = errors.New("webhook address is not public")
+
+var client = &http.Client{
+	Timeout: timeout,
+	// the address is checked again on every connection, as the host may
+	// resolve to another one than when the webhook was created
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: timeout,
+			Control: func(network string, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || !Public(ip) {
+					return ErrNotPublic
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout: timeout,
+	},
+	// a redirect is a status other than 2xx like any other, not followed
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// Delivery is a payload to post to a webhook.
+type Delivery struct {
+	ID        uint64
+	URL       string
+	Secret    string
+	EventType int
+	Payload   []byte
+}
+
+// Public tells if ip is a public address, not a loopback, private, link-local,
+// multicast or unspecified one.
+func Public(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// CheckHost returns ErrNotPublic unless every address host resolves to is
+// public.
+func CheckHost(ctx context.Context, host string) error {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if !Public(addr.IP) {
+			return ErrNotPublic
+		}
+	}
+	return nil
+}
+
+// NewSecret returns a random secret to sign the deliveries of a webhook with.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature of body sent at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts the delivery and returns the response status code, zero if there
+// was no response. Any status other than 2xx is an error.
+func Send(ctx context.Context, d *Delivery) (int, error) {
+	return send(ctx, client, d)
+}
+
+func send(ctx context.Context, client *http.Client, d *Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(d.Secret, timestamp, d.Payload))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(d.ID, 10))
+	req.Header.Set(HeaderEvent, strconv.Itoa(d.EventType))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, maxResponseSize))
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// Retry returns how long after the failed attempt, counted from 1, to send the
+// delivery again, and false once the delivery is out of attempts.
+func Retry(attempt int) (time.Duration, bool) {
+	if attempt < 1 || attempt >= maxAttempts {
+		return 0, false
+	}
+	return retryBase << (attempt - 1), true
+}
@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	// echo -n '1700000000.{"walletId":1}' | openssl dgst -sha256 -hmac secret
+	want := "sha256=70c3057b992baeb8d77040cb7a193f45eab40a13286a2ea8ff58db85c37a36a4"
+	got := Sign("secret", 1700000000, []byte(`{"walletId":1}`))
+	if got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("other", 1700000000, []byte(`{"walletId":1}`)) == got {
+		t.Error("Sign does not depend on the secret")
+	}
+	if Sign("secret", 1700000001, []byte(`{"walletId":1}`)) == got {
+		t.Error("Sign does not depend on the timestamp")
+	}
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{"ok", http.StatusOK, false},
+		{"no content", http.StatusNoContent, false},
+		{"redirect", http.StatusFound, true},
+		{"bad request", http.StatusBadRequest, true},
+		{"server error", http.StatusInternalServerError, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				body, _ = io.ReadAll(r.Body)
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/elsewhere")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			d := &Delivery{
+				ID:        42,
+				URL:       server.URL,
+				Secret:    "secret",
+				EventType: 1,
+				Payload:   []byte(`{"walletId":1}`),
+			}
+			before := time.Now().Unix()
+			c := server.Client()
+			c.CheckRedirect = client.CheckRedirect
+			statusCode, err := send(context.Background(), c, d)
+			if statusCode != tt.status {
+				t.Errorf("status code %d, want %d", statusCode, tt.status)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error %v, want error %t", err, tt.wantErr)
+			}
+
+			if received == nil {
+				t.Fatal("nothing received")
+			}
+			if received.Method != http.MethodPost {
+				t.Errorf("method %s, want POST", received.Method)
+			}
+			if string(body) != string(d.Payload) {
+				t.Errorf("body %s, want %s", body, d.Payload)
+			}
+			if contentType := received.Header.Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("content type %s, want application/json", contentType)
+			}
+			if delivery := received.Header.Get(HeaderDelivery); delivery != "42" {
+				t.Errorf("delivery header %s, want 42", delivery)
+			}
+			if event := received.Header.Get(HeaderEvent); event != "1" {
+				t.Errorf("event header %s, want 1", event)
+			}
+			timestamp, err := strconv.ParseInt(received.Header.Get(HeaderTimestamp), 10, 64)
+			if err != nil || timestamp < before || timestamp > time.Now().Unix() {
+				t.Errorf("timestamp header %s not the time of sending", received.Header.Get(HeaderTimestamp))
+			}
+			if signature := received.Header.Get(HeaderSignature); signature != Sign(d.Secret, timestamp, d.Payload) {
+				t.Errorf("signature header %s does not match the body", signature)
+			}
+		})
+	}
+}
+
+func TestSendNotPublic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("delivery sent to a loopback address")
+	}))
+	defer server.Close()
+
+	statusCode, err := Send(context.Background(), &Delivery{
+		ID:      42,
+		URL:     server.URL,
+		Secret:  "secret",
+		Payload: []byte(`{"walletId":1}`),
+	})
+	if statusCode != 0 || !errors.Is(err, ErrNotPublic) {
+		t.Errorf("Send = %d, %v, want %v", statusCode, err, ErrNotPublic)
+	}
+}
+
+func TestPublic(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"8.8.8.8", true},
+		{"203.0.113.10", true},
+		{"2001:4860:4860::8888", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"224.0.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		if got := Public(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("Public(%s) = %t, want %t", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	tests := []struct {
+		host string
+		err  error
+	}{
+		{"8.8.8.8", nil},
+		{"127.0.0.1", ErrNotPublic},
+		{"169.254.169.254", ErrNotPublic},
+		{"::1", ErrNotPublic},
+	}
+
+	for _, tt := range tests {
+		if err := CheckHost(context.Background(), tt.host); err != tt.err {
+			t.Errorf("CheckHost(%s) = %v, want %v", tt.host, err, tt.err)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+		ok      bool
+	}{
+		{0, 0, false},
+		{1, 30 * time.Second, true},
+		{2, time.Minute, true},
+		{3, 2 * time.Minute, true},
+		{4, 4 * time.Minute, true},
+		{10, 256 * time.Minute, true},
+		{11, 0, false},
+		{12, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Retry(tt.attempt)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Retry(%d) = %s, %t, want %s, %t", tt.attempt, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	// every attempt but the last is retried, within about eight and a half hours
+	var total time.Duration
+	for attempt := 1; ; attempt++ {
+		retry, ok := Retry(attempt)
+		if !ok {
+			if attempt != maxAttempts {
+				t.Errorf("retries stop after attempt %d, want %d", attempt, maxAttempts)
+			}
+			break
+		}
+		total += retry
+	}
+	if total != 1023*retryBase {
+		t.Errorf("retries span %s, want %s", total, 1023*retryBase)
+	}
+}